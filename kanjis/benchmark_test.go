@@ -6,6 +6,8 @@ import (
 	"path/filepath"
 	"strings"
 	"testing"
+
+	"github.com/KEINOS/go-joyokanjis/kanjis/kanji"
 )
 
 // ----------------------------------------------------------------------------
@@ -77,3 +79,32 @@ func getData(b *testing.B) string {
 
 	return testDataBigSize
 }
+
+// The index of FindByReading should be much faster than the scan of
+// kanji.Dict.FindByReading.
+func Benchmark_FindByReading(b *testing.B) {
+	const opts = kanji.ReadingFoldKana
+
+	b.Run("FindByReading", func(b *testing.B) {
+		_ = FindByReading("がく", opts) // Create the index beforehand
+
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			_ = FindByReading("がく", opts)
+		}
+	})
+
+	b.Run("Dict.FindByReading", func(b *testing.B) {
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			_ = kanjiDict.FindByReading("がく", opts)
+		}
+	})
+}
+
+func Benchmark_EqualFold(b *testing.B) {
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = EqualFold("渡邊ﾜﾀﾅﾍﾞ", "渡辺わたなべ", FoldKana|FoldWidth)
+	}
+}
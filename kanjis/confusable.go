@@ -0,0 +1,306 @@
+package kanjis
+
+import (
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// ----------------------------------------------------------------------------
+//  Confusable data
+// ----------------------------------------------------------------------------
+
+// confusableGroups is the list of visually confusable Japanese characters. The
+// first character of each group is the prototype used as the skeleton.
+//
+// Note that the characters normalized by NFKC (such as Kangxi radicals, CJK
+// compatibility ideographs and halfwidth katakana) are not listed here.
+var confusableGroups = [][]rune{
+	// Kanji and katakana
+	{'口', 'ロ', '囗', '□'},
+	{'工', 'エ'},
+	{'一', 'ー', '—', '―', '─', '━', '㇐'},
+	{'力', 'カ'},
+	{'夕', 'タ'},
+	{'二', 'ニ'},
+	{'卜', 'ト'},
+	{'八', 'ハ'},
+	{'千', 'チ'},
+	{'乂', 'メ'},
+	{'匕', 'ヒ'},
+	{'厶', 'ム'},
+	{'又', 'ヌ'},
+	{'才', 'オ'},
+	{'丿', 'ノ'},
+	{'〇', '○', '◯'},
+	// Hiragana and katakana
+	{'へ', 'ヘ'},
+	{'べ', 'ベ'},
+	{'ぺ', 'ペ'},
+	{'り', 'リ'},
+	// Kanji and kanji
+	{'己', '已', '巳'},
+	{'土', '士'},
+	{'未', '末'},
+	{'人', '入'},
+	{'日', '曰'},
+	{'戊', '戌', '戍'},
+}
+
+// confusablePrototype is the map of the confusable characters to its prototype.
+// Generated from confusableGroups.
+var confusablePrototype = func() map[rune]rune {
+	prototypes := make(map[rune]rune)
+
+	for _, group := range confusableGroups {
+		for _, char := range group[1:] {
+			prototypes[char] = group[0]
+		}
+	}
+
+	return prototypes
+}()
+
+// confusableScripts is the map of the prototype to the scripts of the
+// characters in its group.
+var confusableScripts = func() map[rune][]script {
+	scripts := make(map[rune][]script)
+
+	for _, group := range confusableGroups {
+		for _, char := range group {
+			scripts[group[0]] = append(scripts[group[0]], scriptOf(char))
+		}
+	}
+
+	return scripts
+}()
+
+// ----------------------------------------------------------------------------
+//  Type: Confusable
+// ----------------------------------------------------------------------------
+
+// Confusable is a record of a suspicious character found by Confusables.
+type Confusable struct {
+	// Token is the token (run of non-space characters) that contains the
+	// character.
+	Token string `json:"token"`
+	// Reason is the reason why the character is suspicious.
+	Reason string `json:"reason"`
+	// Offset is the byte offset of the character in the input.
+	Offset int `json:"offset"`
+	// Char is the suspicious character.
+	Char rune `json:"char"`
+	// Skeleton is the prototype of the character that it can be confused with.
+	Skeleton rune `json:"skeleton"`
+}
+
+// ----------------------------------------------------------------------------
+//  Type: script
+// ----------------------------------------------------------------------------
+
+// script is the simplified script of a character to detect mixed-script tokens.
+type script int
+
+const (
+	scriptOther script = iota
+	scriptHan
+	scriptHiragana
+	scriptKatakana
+)
+
+// scriptOf returns the script of the given character. The prolonged sound mark
+// ('ー') is considered as katakana.
+func scriptOf(char rune) script {
+	switch {
+	case char == 'ー' || unicode.Is(unicode.Katakana, char):
+		return scriptKatakana
+	case unicode.Is(unicode.Hiragana, char):
+		return scriptHiragana
+	case unicode.Is(unicode.Han, char):
+		return scriptHan
+	}
+
+	return scriptOther
+}
+
+// ----------------------------------------------------------------------------
+//  Public functions
+// ----------------------------------------------------------------------------
+
+// Confusables returns the suspicious characters in the input that can be
+// visually confused with others. Such as katakana 'ロ' in "ロ座" (the kanji '口'
+// is expected) or kanji '一' in "コ一ヒ一" (the prolonged sound mark 'ー' is
+// expected).
+//
+// The input is split into tokens by white spaces. A character is reported if:
+//
+//   - It is a Kangxi radical, CJK radical or CJK compatibility ideograph, which
+//     look the same as the ordinary kanji.
+//   - It is a confusable character in a token of a different script, and the
+//     script of the token matches the one of the confusable counterpart. The
+//     script of the token is the most used one of the non-confusable characters.
+func Confusables(input string) []Confusable {
+	var (
+		found      []Confusable
+		tokenStart = -1
+	)
+
+	for offset := 0; offset <= len(input); {
+		char, size := utf8.DecodeRuneInString(input[offset:])
+
+		if offset == len(input) || unicode.IsSpace(char) {
+			if tokenStart >= 0 {
+				found = append(found, confusablesInToken(input[tokenStart:offset], tokenStart)...)
+				tokenStart = -1
+			}
+
+			if offset == len(input) {
+				break
+			}
+		} else if tokenStart < 0 {
+			tokenStart = offset
+		}
+
+		offset += size
+	}
+
+	return found
+}
+
+// IsConfusable returns true if the given strings have the same skeleton. Which
+// means that they look the same or similar. E.g. "エ場" and "工場".
+func IsConfusable(a, b string) bool {
+	return Skeleton(a) == Skeleton(b)
+}
+
+// Skeleton returns the skeleton of the given string. The visually confusable
+// characters are mapped to a common prototype, thus strings that look similar
+// have the same skeleton. E.g. "ロ座" and "口座".
+//
+// The string is normalized by NFKC first. So the Kangxi radicals, CJK
+// compatibility ideographs, fullwidth ASCII and halfwidth katakana are also
+// mapped to the ordinary characters.
+//
+// Note that the skeleton is for comparison only and not for display.
+func Skeleton(input string) string {
+	normalized := []rune(norm.NFKC.String(input))
+
+	for i, char := range normalized {
+		normalized[i] = skeletonRune(char)
+	}
+
+	return string(normalized)
+}
+
+// ----------------------------------------------------------------------------
+//  Private functions
+// ----------------------------------------------------------------------------
+
+// confusablesInToken returns the suspicious characters in the given token. The
+// offset is the byte offset of the token in the input.
+func confusablesInToken(token string, offset int) []Confusable {
+	var (
+		found   []Confusable
+		chars   = []rune(token)
+		offsets = make([]int, 0, len(chars))
+	)
+
+	for index := range token {
+		offsets = append(offsets, offset+index)
+	}
+
+	tokenScript := dominantScript(chars)
+
+	for i, char := range chars {
+		record := Confusable{
+			Token:    token,
+			Offset:   offsets[i],
+			Char:     char,
+			Skeleton: skeletonRune(char),
+		}
+
+		if isRadicalOrCompatibility(char) {
+			record.Skeleton = skeletonRune([]rune(norm.NFKC.String(string(char)))[0])
+			record.Reason = "radical or compatibility ideograph"
+			found = append(found, record)
+
+			continue
+		}
+
+		if _, ok := confusableScripts[record.Skeleton]; !ok {
+			continue
+		}
+
+		if isMixedScript(char, tokenScript) {
+			record.Reason = "mixed script"
+			found = append(found, record)
+		}
+	}
+
+	return found
+}
+
+// dominantScript returns the most used script in the given characters. The
+// confusable characters are not counted. It returns scriptOther if no script
+// is found.
+func dominantScript(chars []rune) script {
+	counts := make(map[script]int)
+	dominant := scriptOther
+
+	for _, char := range chars {
+		if _, ok := confusableScripts[skeletonRune(char)]; ok {
+			continue
+		}
+
+		current := scriptOf(char)
+		if current == scriptOther {
+			continue
+		}
+
+		counts[current]++
+
+		if counts[current] > counts[dominant] {
+			dominant = current
+		}
+	}
+
+	return dominant
+}
+
+// isMixedScript returns true if the given character is not in the script of
+// the token but its confusable counterpart is.
+func isMixedScript(char rune, tokenScript script) bool {
+	charScript := scriptOf(char)
+	if tokenScript == scriptOther || tokenScript == charScript {
+		return false
+	}
+
+	for _, counterpart := range confusableScripts[skeletonRune(char)] {
+		if counterpart == tokenScript {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isRadicalOrCompatibility returns true if the given character is a Kangxi
+// radical, CJK radical or CJK compatibility ideograph that has a normalized
+// (NFKC) form.
+func isRadicalOrCompatibility(char rune) bool {
+	if !unicode.In(char, unicode.Radical, unicode.Ideographic) {
+		return false
+	}
+
+	return norm.NFKC.String(string(char)) != string(char)
+}
+
+// skeletonRune returns the prototype of the given character.
+func skeletonRune(char rune) rune {
+	if prototype, ok := confusablePrototype[char]; ok {
+		return prototype
+	}
+
+	return char
+}
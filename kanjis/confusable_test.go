@@ -0,0 +1,80 @@
+package kanjis
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ----------------------------------------------------------------------------
+//  Confusables()
+// ----------------------------------------------------------------------------
+
+func TestConfusables(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		input  string
+		expect []Confusable
+	}{
+		{
+			input: "エ場",
+			expect: []Confusable{
+				{Token: "エ場", Reason: "mixed script", Offset: 0, Char: 'エ', Skeleton: '工'},
+			},
+		},
+		{
+			input: "会員 コ一ヒ一",
+			expect: []Confusable{
+				{Token: "コ一ヒ一", Reason: "mixed script", Offset: 10, Char: '一', Skeleton: '一'},
+				{Token: "コ一ヒ一", Reason: "mixed script", Offset: 16, Char: '一', Skeleton: '一'},
+			},
+		},
+		{
+			input: "へヤ\t\u2F08間", // Kangxi radical (U+2F08)
+			expect: []Confusable{
+				{Token: "へヤ", Reason: "mixed script", Offset: 0, Char: 'へ', Skeleton: 'へ'},
+				{Token: "\u2F08間", Reason: "radical or compatibility ideograph", Offset: 7, Char: '\u2F08', Skeleton: '人'},
+			},
+		},
+		{
+			input: "\uFA47字", // CJK Compatibility Ideograph of '漢'
+			expect: []Confusable{
+				{Token: "\uFA47字", Reason: "radical or compatibility ideograph", Offset: 0, Char: '\uFA47', Skeleton: '漢'},
+			},
+		},
+		// No confusables
+		{input: "", expect: nil},
+		{input: "ロボット 一人 工場", expect: nil},
+		{input: "力を入れる", expect: nil},
+	} {
+		require.Equal(t, test.expect, Confusables(test.input),
+			"Confusables(%q) returned unexpected result", test.input)
+	}
+}
+
+// ----------------------------------------------------------------------------
+//  IsConfusable()
+// ----------------------------------------------------------------------------
+
+func TestIsConfusable(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		a, b   string
+		expect bool
+	}{
+		{"工場", "エ場", true},
+		{"口座", "ロ座", true},
+		{"コーヒー", "コ一ヒ一", true},
+		{"人間", "\u2F08間", true}, // Kangxi radical
+		{"カタカナ", "ｶﾀｶﾅ", true},  // Halfwidth katakana
+		{"漢字", "漢字", true},      // CJK Compatibility Ideograph
+		{"学校", "學校", false},     // Old kanji is not a visual confusable
+		{"工場", "工揚", false},
+	} {
+		assert.Equal(t, test.expect, IsConfusable(test.a, test.b),
+			"IsConfusable(%q, %q) returned unexpected result", test.a, test.b)
+	}
+}
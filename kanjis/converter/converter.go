@@ -1,13 +1,52 @@
 package converter
 
 import (
+	"bufio"
 	"io"
+	"unicode/utf8"
 
 	"github.com/pkg/errors"
 	"golang.org/x/text/runes"
 	"golang.org/x/text/transform"
 )
 
+// ----------------------------------------------------------------------------
+//  Type: Position
+// ----------------------------------------------------------------------------
+
+// Position is the location of a character in the input.
+type Position struct {
+	// Offset is the byte offset from the beginning of the input (0-based).
+	Offset int64 `json:"offset"`
+	// Line is the line number (1-based).
+	Line int `json:"line"`
+	// Column is the column number in runes (1-based).
+	Column int `json:"column"`
+}
+
+// advance moves the position forward by the given character of the given size
+// in bytes.
+func (p *Position) advance(char rune, size int) {
+	p.Offset += int64(size)
+
+	if char == '\n' {
+		p.Line++
+		p.Column = 1
+
+		return
+	}
+
+	p.Column++
+}
+
+// ----------------------------------------------------------------------------
+//  Type: MapFunc
+// ----------------------------------------------------------------------------
+
+// MapFunc is the function type used by NewMapper. It receives the character
+// and its position in the input and returns the string to replace it with.
+type MapFunc func(in rune, pos Position) string
+
 // ----------------------------------------------------------------------------
 //  Type: Converter
 // ----------------------------------------------------------------------------
@@ -16,6 +55,7 @@ import (
 // method `Convert` to convert the input to the output.
 type Converter struct {
 	runeTransformer runes.Transformer
+	mapFunc         MapFunc
 }
 
 // ----------------------------------------------------------------------------
@@ -31,16 +71,116 @@ func New(fn func(in rune) rune) Converter {
 	}
 }
 
+// NewMapper is similar to New but the given function also receives the position
+// of the character in the input and returns a string. Thus, a character can be
+// replaced with several characters or omitted by returning an empty string.
+//
+// The function is called exactly once per character and in the order of the
+// input. Which makes it suitable to record the changes made during the
+// conversion.
+func NewMapper(fn MapFunc) Converter {
+	return Converter{
+		mapFunc: fn,
+	}
+}
+
+// ----------------------------------------------------------------------------
+//  Functions
+// ----------------------------------------------------------------------------
+
+// Scan reads the input until EOF is reached and calls the given function for
+// each character with its position. It is similar to Convert of NewMapper but
+// does not write any output.
+func Scan(input io.Reader, fn func(in rune, pos Position)) error {
+	if input == nil {
+		return errors.New("input is nil")
+	}
+
+	reader := bufio.NewReader(input)
+	pos := Position{Line: 1, Column: 1}
+
+	for {
+		char, size, err := reader.ReadRune()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+
+			return errors.Wrap(err, "failed to read the input")
+		}
+
+		fn(char, pos)
+		pos.advance(char, size)
+	}
+}
+
 // ----------------------------------------------------------------------------
 //  Methods
 // ----------------------------------------------------------------------------
 
+// Convert reads the input, converts the characters and writes them to the
+// output until EOF is reached.
 func (trns *Converter) Convert(input io.Reader, output io.Writer) error {
 	if input == nil || output == nil {
 		return errors.New("input or output is nil")
 	}
 
-	_, err := io.Copy(output, transform.NewReader(input, trns.runeTransformer))
+	var tf transform.Transformer = trns.runeTransformer
+
+	if trns.mapFunc != nil {
+		tf = &mapper{fn: trns.mapFunc}
+	}
+
+	_, err := io.Copy(output, transform.NewReader(input, tf))
 
 	return errors.Wrap(err, "failed to copy the input to the output")
 }
+
+// ----------------------------------------------------------------------------
+//  Type: mapper
+// ----------------------------------------------------------------------------
+
+// mapper is a transform.Transformer that keeps track of the position of the
+// characters being converted.
+type mapper struct {
+	fn         MapFunc
+	out        string
+	pos        Position
+	hasPending bool
+}
+
+// Reset implements the transform.Transformer interface.
+func (m *mapper) Reset() {
+	m.out = ""
+	m.pos = Position{Line: 1, Column: 1}
+	m.hasPending = false
+}
+
+// Transform implements the transform.Transformer interface.
+func (m *mapper) Transform(dst, src []byte, atEOF bool) (nDst, nSrc int, err error) {
+	for nSrc < len(src) {
+		char, size := utf8.DecodeRune(src[nSrc:])
+		if char == utf8.RuneError && !atEOF && !utf8.FullRune(src[nSrc:]) {
+			return nDst, nSrc, transform.ErrShortSrc
+		}
+
+		// Keep the converted string in case the destination is short, so that
+		// the function is not called twice for the same character.
+		if !m.hasPending {
+			m.out = m.fn(char, m.pos)
+			m.hasPending = true
+		}
+
+		if nDst+len(m.out) > len(dst) {
+			return nDst, nSrc, transform.ErrShortDst
+		}
+
+		nDst += copy(dst[nDst:], m.out)
+		nSrc += size
+		m.hasPending = false
+
+		m.pos.advance(char, size)
+	}
+
+	return nDst, nSrc, nil
+}
@@ -2,8 +2,10 @@ package converter
 
 import (
 	"bytes"
+	"errors"
 	"strings"
 	"testing"
+	"testing/iotest"
 
 	"github.com/stretchr/testify/require"
 )
@@ -34,3 +36,84 @@ func TestConverter_Convert_nil_input(t *testing.T) {
 			"error message should contain the error reason")
 	})
 }
+
+func TestNewMapper_position(t *testing.T) {
+	type record struct {
+		char rune
+		pos  Position
+	}
+
+	var records []record
+
+	tf := NewMapper(func(in rune, pos Position) string {
+		records = append(records, record{char: in, pos: pos})
+
+		return string(in)
+	})
+
+	var bWriter bytes.Buffer
+
+	err := tf.Convert(strings.NewReader("aあ\n漢b"), &bWriter)
+
+	require.NoError(t, err)
+	require.Equal(t, "aあ\n漢b", bWriter.String())
+	require.Equal(t, []record{
+		{char: 'a', pos: Position{Offset: 0, Line: 1, Column: 1}},
+		{char: 'あ', pos: Position{Offset: 1, Line: 1, Column: 2}},
+		{char: '\n', pos: Position{Offset: 4, Line: 1, Column: 3}},
+		{char: '漢', pos: Position{Offset: 5, Line: 2, Column: 1}},
+		{char: 'b', pos: Position{Offset: 8, Line: 2, Column: 2}},
+	}, records)
+}
+
+func TestNewMapper_large_input(t *testing.T) {
+	// Input larger than the internal buffer of transform.Reader to ensure the
+	// function is called once per character even on short destination.
+	input := strings.Repeat("あいう", 10000)
+
+	count := 0
+
+	tf := NewMapper(func(in rune, pos Position) string {
+		count++
+
+		return strings.Repeat(string(in), 2)
+	})
+
+	var bWriter bytes.Buffer
+
+	require.NoError(t, tf.Convert(strings.NewReader(input), &bWriter))
+	require.Equal(t, 30000, count, "the function should be called once per character")
+	require.Equal(t, strings.Repeat("ああいいうう", 10000), bWriter.String())
+}
+
+func TestScan(t *testing.T) {
+	var positions []Position
+
+	err := Scan(strings.NewReader("a\nあb"), func(in rune, pos Position) {
+		positions = append(positions, pos)
+	})
+
+	require.NoError(t, err)
+	require.Equal(t, []Position{
+		{Offset: 0, Line: 1, Column: 1},
+		{Offset: 1, Line: 1, Column: 2},
+		{Offset: 2, Line: 2, Column: 1},
+		{Offset: 5, Line: 2, Column: 2},
+	}, positions)
+}
+
+func TestScan_nil_input(t *testing.T) {
+	err := Scan(nil, func(in rune, pos Position) {})
+
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "input is nil",
+		"error message should contain the error reason")
+}
+
+func TestScan_fail_read(t *testing.T) {
+	err := Scan(iotest.ErrReader(errors.New("forced error")), func(in rune, pos Position) {})
+
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "failed to read the input: forced error",
+		"error message should contain the error reason")
+}
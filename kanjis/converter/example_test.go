@@ -52,3 +52,35 @@ func Example_toUpper() {
 	fmt.Println(bWriter.String())
 	// Output: HELLO, WORLD!
 }
+
+func ExampleNewMapper() {
+	tf := converter.NewMapper(
+		// This function replaces the tab character with 4 spaces and reports
+		// its position.
+		func(in rune, pos converter.Position) string {
+			if in != '\t' {
+				return string(in)
+			}
+
+			fmt.Printf("tab found at line %d, column %d\n", pos.Line, pos.Column)
+
+			return "    "
+		},
+	)
+
+	// Input reader
+	input := "Hello,\n\tWorld!"
+	sReader := strings.NewReader(input)
+
+	// Output writer
+	var bWriter bytes.Buffer
+
+	// Convert
+	tf.Convert(sReader, &bWriter)
+
+	fmt.Println(bWriter.String())
+	// Output:
+	// tab found at line 2, column 1
+	// Hello,
+	//     World!
+}
@@ -0,0 +1,111 @@
+package kanjis
+
+import (
+	"testing"
+
+	"github.com/KEINOS/go-joyokanjis/kanjis/kanji"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ----------------------------------------------------------------------------
+//  IsJoyoKanjiIn()
+// ----------------------------------------------------------------------------
+
+// This test ensures the number of kanji in each edition.
+func TestIsJoyoKanjiIn_count(t *testing.T) {
+	for _, test := range []struct {
+		edition kanji.Edition
+		expect  int
+	}{
+		{edition: kanji.Toyo1946, expect: 1850},
+		{edition: kanji.Joyo1981, expect: 1945},
+		{edition: kanji.Joyo2010, expect: 2136},
+	} {
+		count := 0
+
+		for _, char := range editionCandidates() {
+			if IsJoyoKanjiIn(char, test.edition) {
+				count++
+			}
+		}
+
+		assert.Equal(t, test.expect, count, "edition: %s", test.edition)
+	}
+}
+
+func TestIsJoyoKanjiIn(t *testing.T) {
+	for _, test := range []struct {
+		char    rune
+		edition kanji.Edition
+		expect  bool
+	}{
+		{char: '一', edition: kanji.Toyo1946, expect: true},
+		{char: '一', edition: kanji.Joyo1981, expect: true},
+		{char: '一', edition: kanji.Joyo2010, expect: true},
+		// Added in 1981
+		{char: '猫', edition: kanji.Toyo1946, expect: false},
+		{char: '猫', edition: kanji.Joyo1981, expect: true},
+		{char: '猫', edition: kanji.Joyo2010, expect: true},
+		// Added in 2010
+		{char: '鬱', edition: kanji.Joyo1981, expect: false},
+		{char: '鬱', edition: kanji.Joyo2010, expect: true},
+		// Removed in 2010
+		{char: '匁', edition: kanji.Toyo1946, expect: true},
+		{char: '匁', edition: kanji.Joyo1981, expect: true},
+		{char: '匁', edition: kanji.Joyo2010, expect: false},
+		// Old kanji and non-kanji
+		{char: '學', edition: kanji.Joyo1981, expect: false},
+		{char: 'a', edition: kanji.Toyo1946, expect: false},
+		// Unknown edition
+		{char: '一', edition: kanji.Edition(-1), expect: false},
+	} {
+		assert.Equal(t, test.expect, IsJoyoKanjiIn(test.char, test.edition),
+			"char: %s, edition: %s", string(test.char), test.edition)
+	}
+}
+
+// ----------------------------------------------------------------------------
+//  DiffEditions()
+// ----------------------------------------------------------------------------
+
+func TestDiffEditions(t *testing.T) {
+	for _, test := range []struct {
+		from          kanji.Edition
+		to            kanji.Edition
+		expectAdded   int
+		expectRemoved int
+	}{
+		{from: kanji.Toyo1946, to: kanji.Joyo1981, expectAdded: 95, expectRemoved: 0},
+		{from: kanji.Joyo1981, to: kanji.Joyo2010, expectAdded: 196, expectRemoved: 5},
+		{from: kanji.Toyo1946, to: kanji.Joyo2010, expectAdded: 291, expectRemoved: 5},
+		{from: kanji.Joyo2010, to: kanji.Joyo1981, expectAdded: 5, expectRemoved: 196},
+		{from: kanji.Joyo2010, to: kanji.Joyo2010, expectAdded: 0, expectRemoved: 0},
+	} {
+		added, removed := DiffEditions(test.from, test.to)
+
+		assert.Len(t, added, test.expectAdded, "from %s to %s", test.from, test.to)
+		assert.Len(t, removed, test.expectRemoved, "from %s to %s", test.from, test.to)
+	}
+
+	_, removed := DiffEditions(kanji.Joyo1981, kanji.Joyo2010)
+
+	require.Equal(t, "勺匁脹銑錘", string(removed), "it should be sorted by the code point")
+}
+
+// ----------------------------------------------------------------------------
+//  Helper functions
+// ----------------------------------------------------------------------------
+
+// editionCandidates returns the Joyo Kanji and the kanji removed in 2010.
+func editionCandidates() []rune {
+	candidates := []rune("勺錘銑脹匁")
+
+	for char, kanjiData := range kanjiDict {
+		if !kanjiData.IsKyuJitai {
+			candidates = append(candidates, char)
+		}
+	}
+
+	return candidates
+}
@@ -0,0 +1,171 @@
+package kanjis
+
+import (
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/KEINOS/go-joyokanjis/kanjis/kanji"
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/japanese"
+	"golang.org/x/text/width"
+)
+
+// ----------------------------------------------------------------------------
+//  Type: Encoding
+// ----------------------------------------------------------------------------
+
+// Encoding is a legacy Japanese character encoding to check if the characters
+// are representable in it. Use IsEncodable or RuleEncodable.
+type Encoding int
+
+const (
+	// EncodingShiftJIS is the strict Shift_JIS. Which is JIS X 0201 (the Roman
+	// set and the halfwidth katakana) and JIS X 0208:1997 in the mapping of
+	// x0213.org. Thus, '〜' (U+301C) and '—' (U+2014) are encodable but '～'
+	// (U+FF5E), '―' (U+2015) and the vendor extensions such as '①' are not.
+	//
+	// The byte 0x5C is '¥' (U+00A5) and 0x7E is '‾' (U+203E) in the Roman set.
+	// So '¥' and '‾' are encodable, '\\' (U+005C) is encodable as the 1-1-32 of
+	// JIS X 0208 and '~' (U+007E) is not encodable. The fullwidth forms such as
+	// '￥' (U+FFE5) and '＼' (U+FF3C) are not encodable either.
+	EncodingShiftJIS Encoding = iota
+	// EncodingCP932 is the Windows-31J (Microsoft's Shift_JIS). Which includes
+	// the NEC and IBM extensions such as '①' and '髙', and maps '～' (U+FF5E)
+	// instead of '〜' (U+301C).
+	EncodingCP932
+	// EncodingEUCJP is the EUC-JP. Which includes JIS X 0212 as well. The
+	// characters are mapped the same way as CP932.
+	EncodingEUCJP
+	// EncodingISO2022JP is the ISO-2022-JP (JIS code) used for e-mails. The
+	// characters are mapped the same way as CP932.
+	EncodingISO2022JP
+)
+
+// String is a Stringer interface implementation. E.g. "shift_jis" and "cp932".
+func (e Encoding) String() string {
+	switch e {
+	case EncodingShiftJIS:
+		return "shift_jis"
+	case EncodingCP932:
+		return "cp932"
+	case EncodingEUCJP:
+		return "euc-jp"
+	case EncodingISO2022JP:
+		return "iso-2022-jp"
+	}
+
+	return "unknown"
+}
+
+// textEncoding returns the encoding of golang.org/x/text. It returns nil for
+// the strict Shift_JIS and unknown encodings.
+func (e Encoding) textEncoding() encoding.Encoding {
+	switch e {
+	case EncodingCP932:
+		return japanese.ShiftJIS // WHATWG Shift_JIS, which is CP932
+	case EncodingEUCJP:
+		return japanese.EUCJP
+	case EncodingISO2022JP:
+		return japanese.ISO2022JP
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+//  Public functions
+// ----------------------------------------------------------------------------
+
+// IsEncodable returns true if the given character is representable in the
+// given encoding. Such as '𠮷' (U+20BB7) and the variation selectors of IVS
+// are not encodable in any of them.
+func IsEncodable(char rune, enc Encoding) bool {
+	if enc == EncodingShiftJIS {
+		switch {
+		case char == '~':
+			return false // 0x7E is OVERLINE in the Roman set of JIS X 0201
+		case char < utf8.RuneSelf:
+			return true // 0x5C is YEN SIGN but '\\' is encodable as 1-1-32
+		case char >= '｡' && char <= 'ﾟ':
+			return true // Halfwidth katakana of JIS X 0201
+		}
+
+		kuten, ok := kanji.KutenOf(char)
+
+		return ok && kuten.IsJISX0208()
+	}
+
+	if char < utf8.RuneSelf {
+		return enc >= EncodingCP932 && enc <= EncodingISO2022JP
+	}
+
+	textEnc := enc.textEncoding()
+	if textEnc == nil {
+		return false
+	}
+
+	_, err := textEnc.NewEncoder().String(string(char))
+
+	return err == nil
+}
+
+// RuleEncodable returns the lint rule that detects the characters that are not
+// representable in the given encoding. The rule name is "encodable-" followed
+// by the encoding name. E.g. "encodable-cp932".
+//
+// An encodable replacement is suggested if found in the mapping discrepancies,
+// the old or variant kanji and the fullwidth forms. E.g. '𠮷' to '吉' and '〜'
+// to '～' for CP932.
+func RuleEncodable(enc Encoding) Rule {
+	return Rule{
+		Name: "encodable-" + enc.String(),
+		Check: func(char rune) (Issue, bool) {
+			if IsEncodable(char, enc) {
+				return Issue{}, false
+			}
+
+			issue := Issue{Note: "not encodable in " + enc.String()}
+
+			if unicode.Is(unicode.Variation_Selector, char) {
+				issue.Note = "variation selector not encodable in " + enc.String()
+			}
+
+			if alternative, ok := encodableAlternative(char, enc); ok {
+				issue.Suggestion = alternative
+			}
+
+			return issue, true
+		},
+	}
+}
+
+// ----------------------------------------------------------------------------
+//  Private functions
+// ----------------------------------------------------------------------------
+
+// encodableAlternative returns the first encodable character of the candidates
+// to replace the given character. The candidates are checked in the order of
+// the mapping discrepancies, the new kanji form, the Japanese form of the
+// simplified Chinese, the variant kanji and the fullwidth form.
+func encodableAlternative(char rune, enc Encoding) (rune, bool) {
+	candidates := []rune{
+		FixRuneMapping(char, MappingCP932),
+		FixRuneMapping(char, MappingUnicode),
+		FixRuneAsJoyo(char),
+	}
+
+	if japanese, ok := kanji.SimplifiedToJapanese(char); ok {
+		candidates = append(candidates, japanese)
+	}
+
+	candidates = append(candidates, VariantClass(char)...)
+	candidates = append(candidates, width.LookupRune(char).Wide())
+
+	for _, candidate := range candidates {
+		if candidate != 0 && candidate != char && IsEncodable(candidate, enc) {
+			return candidate, true
+		}
+	}
+
+	return 0, false
+}
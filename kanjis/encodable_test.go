@@ -0,0 +1,142 @@
+package kanjis
+
+import (
+	"testing"
+
+	"github.com/KEINOS/go-joyokanjis/kanjis/converter"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ----------------------------------------------------------------------------
+//  Encoding.String()
+// ----------------------------------------------------------------------------
+
+func TestEncoding_String(t *testing.T) {
+	assert.Equal(t, "shift_jis", EncodingShiftJIS.String())
+	assert.Equal(t, "cp932", EncodingCP932.String())
+	assert.Equal(t, "euc-jp", EncodingEUCJP.String())
+	assert.Equal(t, "iso-2022-jp", EncodingISO2022JP.String())
+	assert.Equal(t, "unknown", Encoding(-1).String())
+}
+
+// ----------------------------------------------------------------------------
+//  IsEncodable()
+// ----------------------------------------------------------------------------
+
+func TestIsEncodable(t *testing.T) {
+	for _, test := range []struct {
+		char rune
+		// Expected results of Shift_JIS, CP932, EUC-JP and ISO-2022-JP
+		expect [4]bool
+	}{
+		{char: 'a', expect: [4]bool{true, true, true, true}},
+		{char: 'ｱ', expect: [4]bool{true, true, true, true}},
+		{char: '亜', expect: [4]bool{true, true, true, true}},
+		{char: '〜', expect: [4]bool{true, false, false, false}}, // U+301C
+		{char: '～', expect: [4]bool{false, true, true, true}},   // U+FF5E
+		{char: '①', expect: [4]bool{false, true, true, true}},   // NEC special character
+		{char: '髙', expect: [4]bool{false, true, true, true}},   // IBM extension
+		{char: '丂', expect: [4]bool{false, false, true, false}}, // JIS X 0212
+		{char: '𠮷', expect: [4]bool{false, false, false, false}},
+		{char: '€', expect: [4]bool{false, false, false, false}},
+		{char: '\U000E0100', expect: [4]bool{false, false, false, false}}, // VARIATION SELECTOR-17
+	} {
+		for i, enc := range []Encoding{EncodingShiftJIS, EncodingCP932, EncodingEUCJP, EncodingISO2022JP} {
+			assert.Equal(t, test.expect[i], IsEncodable(test.char, enc),
+				"char: %q (%U), encoding: %s", test.char, test.char, enc)
+		}
+	}
+}
+
+// The wave dash, the dashes and the yen sign are mapped differently between
+// the strict Shift_JIS (JIS side) and the others (CP932 side).
+func TestIsEncodable_wave_dash_and_dash(t *testing.T) {
+	for _, test := range []struct {
+		char rune
+		// Expected results of Shift_JIS, CP932, EUC-JP and ISO-2022-JP
+		expect [4]bool
+	}{
+		{char: '〜', expect: [4]bool{true, false, false, false}}, // WAVE DASH (U+301C)
+		{char: '～', expect: [4]bool{false, true, true, true}},   // FULLWIDTH TILDE (U+FF5E)
+		{char: '—', expect: [4]bool{true, false, false, false}}, // EM DASH (U+2014)
+		{char: '―', expect: [4]bool{false, true, true, true}},   // HORIZONTAL BAR (U+2015)
+		{char: '¥', expect: [4]bool{true, false, false, false}}, // YEN SIGN (U+00A5)
+		{char: '￥', expect: [4]bool{false, true, true, true}},   // FULLWIDTH YEN SIGN (U+FFE5)
+	} {
+		for i, enc := range []Encoding{EncodingShiftJIS, EncodingCP932, EncodingEUCJP, EncodingISO2022JP} {
+			assert.Equal(t, test.expect[i], IsEncodable(test.char, enc),
+				"char: %q (%U), encoding: %s", test.char, test.char, enc)
+		}
+	}
+}
+
+// The strict Shift_JIS maps 0x5C and 0x7E to the yen sign and the overline of
+// the Roman set of JIS X 0201. The backslash is the 1-1-32 of JIS X 0208.
+func TestIsEncodable_shift_jis_roman_set(t *testing.T) {
+	for _, test := range []struct {
+		char   rune
+		expect bool
+	}{
+		{char: '¥', expect: true},  // YEN SIGN (U+00A5), 0x5C
+		{char: '‾', expect: true},  // OVERLINE (U+203E), 0x7E
+		{char: '\\', expect: true}, // REVERSE SOLIDUS (U+005C), 1-1-32
+		{char: '~', expect: false}, // TILDE (U+007E)
+		{char: '￥', expect: false}, // FULLWIDTH YEN SIGN (U+FFE5)
+		{char: '＼', expect: false}, // FULLWIDTH REVERSE SOLIDUS (U+FF3C)
+		{char: '￣', expect: false}, // FULLWIDTH MACRON (U+FFE3)
+	} {
+		assert.Equal(t, test.expect, IsEncodable(test.char, EncodingShiftJIS),
+			"char: %q (%U)", test.char, test.char)
+	}
+}
+
+func TestIsEncodable_unknown_encoding(t *testing.T) {
+	assert.False(t, IsEncodable('a', Encoding(-1)))
+	assert.False(t, IsEncodable('亜', Encoding(99)))
+}
+
+// ----------------------------------------------------------------------------
+//  RuleEncodable()
+// ----------------------------------------------------------------------------
+
+func TestRuleEncodable(t *testing.T) {
+	issues := LintString("𠮷野家〜葛\U000E0100飾", RuleEncodable(EncodingCP932))
+
+	require.Equal(t, []Issue{
+		{
+			Pos:  converter.Position{Offset: 0, Line: 1, Column: 1},
+			Rule: "encodable-cp932", Note: "not encodable in cp932",
+			Char: '𠮷', Suggestion: '吉',
+		},
+		{
+			Pos:  converter.Position{Offset: 10, Line: 1, Column: 4},
+			Rule: "encodable-cp932", Note: "not encodable in cp932",
+			Char: '〜', Suggestion: '～',
+		},
+		{
+			Pos:  converter.Position{Offset: 16, Line: 1, Column: 6},
+			Rule: "encodable-cp932", Note: "variation selector not encodable in cp932",
+			Char: '\U000E0100',
+		},
+	}, issues)
+}
+
+func TestRuleEncodable_suggestion(t *testing.T) {
+	for _, test := range []struct {
+		char   rune
+		enc    Encoding
+		expect rune
+	}{
+		{char: '～', enc: EncodingShiftJIS, expect: '〜'}, // Mapping discrepancy
+		{char: '①', enc: EncodingShiftJIS, expect: 0},
+		{char: '髙', enc: EncodingShiftJIS, expect: '高'}, // Variant kanji
+		{char: '说', enc: EncodingCP932, expect: '説'},    // Simplified Chinese
+		{char: '¥', enc: EncodingCP932, expect: '￥'},    // Fullwidth form
+	} {
+		issues := LintString(string(test.char), RuleEncodable(test.enc))
+
+		require.Len(t, issues, 1, "char: %q, encoding: %s", test.char, test.enc)
+		assert.Equal(t, test.expect, issues[0].Suggestion, "char: %q, encoding: %s", test.char, test.enc)
+	}
+}
@@ -5,8 +5,11 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"unicode"
 
 	"github.com/KEINOS/go-joyokanjis/kanjis"
+	"github.com/KEINOS/go-joyokanjis/kanjis/gaiji"
+	"github.com/KEINOS/go-joyokanjis/kanjis/kanji"
 	"github.com/MakeNowJust/heredoc"
 )
 
@@ -155,3 +158,287 @@ func ExampleLenDict() {
 	fmt.Println(kanjis.LenDict())
 	// Output: 2136
 }
+
+func ExampleFixStringMapping() {
+	// Text round-tripped through Windows systems (CP932)
+	input := "東京〜大阪、気温−3度"
+
+	// Canonicalize to CP932 safe characters
+	output, changes := kanjis.FixStringMapping(input, kanjis.MappingCP932)
+
+	fmt.Println(output)
+
+	for _, change := range changes {
+		fmt.Printf("line %d, column %d: %U -> %U\n",
+			change.Pos.Line, change.Pos.Column, change.From, change.To)
+	}
+
+	// Canonicalize back to Unicode (JIS X 0208 mapping)
+	output, _ = kanjis.FixStringMapping(output, kanjis.MappingUnicode)
+
+	fmt.Println(output)
+	// Output:
+	// 東京～大阪、気温－3度
+	// line 1, column 3: U+301C -> U+FF5E
+	// line 1, column 9: U+2212 -> U+FF0D
+	// 東京〜大阪、気温−3度
+}
+
+func ExampleFixStringAsJoyoWithGaiji() {
+	// Gaiji (外字) mapping table. Usually loaded from a file via gaiji.LoadFile().
+	table, err := gaiji.Load(strings.NewReader(heredoc.Doc(`
+		# PUA   Replacement
+		U+E000  U+9AD9  # 髙
+		U+E001  學      # Old kanji will be fixed as well
+	`)))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	input := "橋さんは校へった。"
+
+	output, unmapped := kanjis.FixStringAsJoyoWithGaiji(input, table)
+
+	fmt.Println(output)
+
+	for _, u := range unmapped {
+		fmt.Printf("unmapped %U at line %d, column %d\n", u.Char, u.Pos.Line, u.Pos.Column)
+	}
+	// Output:
+	// 髙橋さんは学校へった。
+	// unmapped U+E0FF at line 1, column 9
+}
+
+func ExampleFixStringAsModern() {
+	// Hentaigana (変体仮名) and old kanji
+	input := "𛂞𛃝𛃱の𛀁𛂺に學ぶ"
+
+	fmt.Println(kanjis.FixStringAsModern(input))
+	// Output: はやりのえほに学ぶ
+}
+
+func ExampleClassify() {
+	for _, char := range []rune{'読', '讀', '读', '薔', 'よ'} {
+		fmt.Printf("%s: %s\n", string(char), kanjis.Classify(char))
+	}
+	// Output:
+	// 読: joyo
+	// 讀: kyujitai
+	// 读: simplified-chinese
+	// 薔: hyogai
+	// よ: not-kanji
+}
+
+func ExampleLintString() {
+	input := "这本书很好读。"
+
+	for _, issue := range kanjis.LintString(input, kanjis.RuleSimplifiedChinese) {
+		fmt.Printf("%d:%d: %s (%s)", issue.Pos.Line, issue.Pos.Column, string(issue.Char), issue.Rule)
+
+		if issue.Suggestion != 0 {
+			fmt.Printf(" -> %s", string(issue.Suggestion))
+		}
+
+		fmt.Println()
+	}
+	// Output:
+	// 1:1: 这 (simplified-chinese) -> 這
+	// 1:3: 书 (simplified-chinese) -> 書
+	// 1:6: 读 (simplified-chinese) -> 読
+}
+
+func ExampleConfusables() {
+	// User name with katakana 'ロ' instead of kanji '口' and kanji '一' instead
+	// of the prolonged sound mark 'ー'.
+	input := "ロ田 コ一ヒ一"
+
+	for _, found := range kanjis.Confusables(input) {
+		fmt.Printf("%s in %q: %s (confusable with %s)\n",
+			string(found.Char), found.Token, found.Reason, string(found.Skeleton))
+	}
+	// Output:
+	// ロ in "ロ田": mixed script (confusable with 口)
+	// 一 in "コ一ヒ一": mixed script (confusable with 一)
+	// 一 in "コ一ヒ一": mixed script (confusable with 一)
+}
+
+func ExampleSkeleton() {
+	fmt.Println(kanjis.Skeleton("エ場") == kanjis.Skeleton("工場"))
+	fmt.Println(kanjis.IsConfusable("ロ田", "口田"))
+	// Output:
+	// true
+	// true
+}
+
+func ExampleToKyuJitai() {
+	// Resolve the ambiguous characters by the word-level table and pick the
+	// most common old form if no word matches.
+	policy, err := kanjis.NewWordPolicy(kanjis.DefaultKyuJitaiWords, kanjis.PolicyDefault)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	output, ambiguities := kanjis.ToKyuJitai("学校の芸術と舞台と弁", policy)
+
+	fmt.Println(output)
+
+	for _, found := range ambiguities {
+		fmt.Printf("%d:%d: %s -> %s (candidates: %s)\n",
+			found.Pos.Line, found.Pos.Column,
+			string(found.Char), string(found.Chosen), string(found.Candidates))
+	}
+	// Output:
+	// 學校の藝術と舞臺と辨
+	// 1:4: 芸 -> 藝 (candidates: 藝芸)
+	// 1:8: 台 -> 臺 (candidates: 臺台)
+	// 1:10: 弁 -> 辨 (candidates: 辨辯瓣弁)
+}
+
+func ExampleEqualFold() {
+	fmt.Println(kanjis.EqualFold("渡辺", "渡邊", kanjis.FoldVariant))
+	fmt.Println(kanjis.EqualFold("渡辺", "渡邉", kanjis.FoldVariant))
+	fmt.Println(kanjis.EqualFold("髙橋", "高橋", kanjis.FoldVariant))
+	fmt.Println(kanjis.EqualFold("ﾜﾀﾅﾍﾞ", "わたなべ", kanjis.FoldKana|kanjis.FoldWidth))
+	// Output:
+	// true
+	// true
+	// true
+	// true
+}
+
+func ExampleVariantClass() {
+	fmt.Println(string(kanjis.VariantClass('辺')))
+	fmt.Println(string(kanjis.VariantClass('弁')))
+	// Output:
+	// 辺邉邊
+	// 弁瓣辨辯
+}
+
+func ExampleNewMatcher() {
+	matcher, err := kanjis.NewMatcher("学校", kanjis.FoldVariant)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	input := "舊制學校と新制学校"
+
+	for _, match := range matcher.FindAllString(input) {
+		fmt.Printf("%d-%d: %s\n", match.Start, match.End, input[match.Start:match.End])
+	}
+	// Output:
+	// 6-12: 學校
+	// 21-27: 学校
+}
+
+func ExampleIsRegistrableInName() {
+	for _, name := range []string{"佐々木凜", "渡邉", "マリー"} {
+		ok, offending := kanjis.IsRegistrableInName(name)
+
+		fmt.Printf("%s: %v %q\n", name, ok, string(offending))
+	}
+
+	fmt.Println("Is '凜' a Jinmeiyo Kanji?:", kanjis.IsJinmeiyoKanji('凜'))
+	fmt.Println("Is '亜' a Jinmeiyo Kanji?:", kanjis.IsJinmeiyoKanji('亜')) // Joyo Kanji
+	// Output:
+	// 佐々木凜: true ""
+	// 渡邉: false "邉"
+	// マリー: true ""
+	// Is '凜' a Jinmeiyo Kanji?: true
+	// Is '亜' a Jinmeiyo Kanji?: false
+}
+
+func ExampleDiffEditions() {
+	added, removed := kanjis.DiffEditions(kanji.Joyo1981, kanji.Joyo2010)
+
+	fmt.Println("Added:", len(added))
+	fmt.Println("Removed:", string(removed))
+	fmt.Println("Is '鬱' in Joyo 1981?:", kanjis.IsJoyoKanjiIn('鬱', kanji.Joyo1981))
+	fmt.Println("Is '匁' in Joyo 1981?:", kanjis.IsJoyoKanjiIn('匁', kanji.Joyo1981))
+	// Output:
+	// Added: 196
+	// Removed: 勺匁脹銑錘
+	// Is '鬱' in Joyo 1981?: false
+	// Is '匁' in Joyo 1981?: true
+}
+
+func ExampleGrade() {
+	input := "漢字の勉強は憂鬱"
+
+	for _, issue := range kanjis.LintString(input, kanjis.Grade(2).Rule()) {
+		fmt.Printf("%s: %s (%s)\n", string(issue.Char), issue.Grade, issue.Note)
+	}
+	// Output:
+	// 漢: grade-3 (taught in grade 3)
+	// 勉: grade-3 (taught in grade 3)
+	// 憂: secondary (taught in secondary school)
+	// 鬱: secondary (taught in secondary school)
+}
+
+func ExampleRuleEncodable() {
+	input := "𠮷野家で〜"
+
+	for _, issue := range kanjis.LintString(input, kanjis.RuleEncodable(kanjis.EncodingCP932)) {
+		fmt.Printf("%d:%d %s -> %s (%s)\n",
+			issue.Pos.Line, issue.Pos.Column, string(issue.Char), string(issue.Suggestion), issue.Note)
+	}
+	// Output:
+	// 1:1 𠮷 -> 吉 (not encodable in cp932)
+	// 1:5 〜 -> ～ (not encodable in cp932)
+}
+
+func ExampleSet() {
+	// Kanji taught in grade 3 only
+	grade3 := kanjis.GradeSet(3).Difference(kanjis.GradeSet(2))
+
+	fmt.Println("Grade 3:", grade3.Len())
+	fmt.Println("Contains '漢':", grade3.Contains('漢'))
+
+	// Joyo Kanji and Jinmeiyo Kanji for names
+	names := kanjis.JoyoSet.Union(kanjis.JinmeiyoSet)
+
+	fmt.Println("Names:", names.Len())
+	fmt.Println("Is '凜' Joyo Kanji?:", unicode.Is(kanjis.JoyoTable, '凜'))
+	fmt.Println("Is '凜' for names?:", unicode.Is(names.RangeTable(), '凜'))
+	// Output:
+	// Grade 3: 200
+	// Contains '漢': true
+	// Names: 2999
+	// Is '凜' Joyo Kanji?: false
+	// Is '凜' for names?: true
+}
+
+func ExampleFindByReading() {
+	// Joyo Kanji whose on-yomi is "ガク"
+	fmt.Println(string(kanjis.FindByReading("ガク", kanji.ReadingOn)))
+
+	// Joyo Kanji whose reading with okurigana starts with "たの"
+	fmt.Println(string(kanjis.FindByReading("たの", kanji.ReadingExample|kanji.ReadingPrefix)))
+	// Output:
+	// 学岳楽額顎
+	// 楽頼
+}
+
+func ExampleFindSpecialWordsString() {
+	input := "明日は友達と七夕に行く"
+
+	for _, word := range kanjis.FindSpecialWordsString(input) {
+		fmt.Printf("%d: %s (%s)\n", word.Pos.Column, word.Word, word.Reading)
+	}
+	// Output:
+	// 1: 明日 (あす)
+	// 4: 友達 (ともだち)
+	// 7: 七夕 (たなばた)
+}
+
+func ExampleReadings() {
+	for _, char := range "学學" {
+		for _, reading := range kanjis.Readings(char) {
+			fmt.Printf("%c: %s (%s)\n", char, reading, reading.Kind)
+		}
+	}
+	// Output:
+	// 学: ガク (on)
+	// 学: まな-ぶ (kun)
+	// 學: ガク (on)
+	// 學: まな-ぶ (kun)
+}
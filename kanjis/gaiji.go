@@ -0,0 +1,71 @@
+package kanjis
+
+import (
+	"bytes"
+	"io"
+	"strings"
+
+	"github.com/KEINOS/go-joyokanjis/kanjis/converter"
+	"github.com/KEINOS/go-joyokanjis/kanjis/gaiji"
+	"github.com/pkg/errors"
+)
+
+// ----------------------------------------------------------------------------
+//  Type: Unmapped
+// ----------------------------------------------------------------------------
+
+// Unmapped is a record of a character in the Private Use Area (gaiji, 外字) that
+// has no mapping in the given gaiji table.
+type Unmapped struct {
+	// Pos is the position of the character in the input.
+	Pos converter.Position `json:"pos"`
+	// Char is the code point in the Private Use Area.
+	Char rune `json:"char"`
+}
+
+// ----------------------------------------------------------------------------
+//  Public functions
+// ----------------------------------------------------------------------------
+
+// FixStringAsJoyoWithGaiji is similar to FixFileAsJoyoWithGaiji but for string.
+func FixStringAsJoyoWithGaiji(input string, table gaiji.Table) (string, []Unmapped) {
+	var output bytes.Buffer
+
+	// Reading from strings.Reader and writing to bytes.Buffer never fail.
+	unmapped, _ := FixFileAsJoyoWithGaiji(strings.NewReader(input), &output, table)
+
+	return output.String(), unmapped
+}
+
+// FixFileAsJoyoWithGaiji is similar to FixFileAsJoyo but it also replaces the
+// characters in the Private Use Area (gaiji, 外字) using the given table in the
+// same pass.
+//
+// The replaced strings are also fixed as Joyo Kanji. The PUA characters that
+// are not in the table are left as is and returned as a list of Unmapped.
+func FixFileAsJoyoWithGaiji(input io.Reader, output io.Writer, table gaiji.Table) ([]Unmapped, error) {
+	if input == nil || output == nil {
+		return nil, errors.New("input or output is nil")
+	}
+
+	var unmapped []Unmapped
+
+	tf := converter.NewMapper(func(in rune, pos converter.Position) string {
+		if !gaiji.IsPUA(in) {
+			return string(FixRuneAsJoyo(in))
+		}
+
+		replacement, ok := table.Find(in)
+		if !ok {
+			unmapped = append(unmapped, Unmapped{Pos: pos, Char: in})
+
+			return string(in)
+		}
+
+		return FixStringAsJoyo(replacement)
+	})
+
+	err := tf.Convert(input, output)
+
+	return unmapped, errors.Wrap(err, "failed to convert the input to the output")
+}
@@ -0,0 +1,176 @@
+/*
+Package gaiji provides a mapping table for user-defined characters (外字, gaiji)
+which are assigned to the Private Use Area (PUA) of Unicode.
+
+Legacy documents exported from vendor Shift_JIS systems often contain gaiji as
+PUA code points. This package loads a PUA to Unicode mapping table to replace
+them with the standard characters.
+
+# Table format
+
+The table is a plain text file with one mapping per line. Each line contains
+the PUA code point and its replacement separated by a tab or spaces.
+
+	# Comments start with '#'. Empty lines are ignored.
+	U+E000	U+9AD9          # Code point(s) of the replacement
+	U+E001	髙               # Or the replacement string itself
+	E002	U+30BB U+309A   # "U+" prefix of the PUA is optional and multiple code points are allowed
+	E003	CAFE            # A single replacement without "U+" is a string. Not U+CAFE
+
+Note that the replacement string itself can not contain spaces.
+*/
+package gaiji
+
+import (
+	"bufio"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/pkg/errors"
+)
+
+// ----------------------------------------------------------------------------
+//  Functions
+// ----------------------------------------------------------------------------
+
+// IsPUA returns true if the given rune is in the Private Use Area of Unicode.
+// Including the Supplementary Private Use Area-A and B.
+func IsPUA(r rune) bool {
+	return unicode.Is(unicode.Co, r)
+}
+
+// ----------------------------------------------------------------------------
+//  Type: Table
+// ----------------------------------------------------------------------------
+
+// Table is a map of gaiji mapping. The key is the code point in the Private Use
+// Area and the value is the string to replace with.
+type Table map[rune]string
+
+// ----------------------------------------------------------------------------
+//  Constructors
+// ----------------------------------------------------------------------------
+
+// Load parses the mapping table from the given reader. See the package document
+// for the format.
+func Load(input io.Reader) (Table, error) {
+	if input == nil {
+		return nil, errors.New("input is nil")
+	}
+
+	table := Table{}
+	scanner := bufio.NewScanner(input)
+	numLine := 0
+
+	for scanner.Scan() {
+		numLine++
+
+		line := scanner.Text()
+
+		// Strip comments
+		if index := strings.Index(line, "#"); index >= 0 {
+			line = line[:index]
+		}
+
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+
+		if len(fields) < 2 {
+			return nil, errors.Errorf("line %d: missing replacement for %q", numLine, fields[0])
+		}
+
+		pua, err := parseCodePoint(fields[0])
+		if err != nil {
+			return nil, errors.Wrapf(err, "line %d: invalid code point", numLine)
+		}
+
+		if !IsPUA(pua) {
+			return nil, errors.Errorf("line %d: %U is not in the Private Use Area", numLine, pua)
+		}
+
+		replacement, err := parseReplacement(fields[1:])
+		if err != nil {
+			return nil, errors.Wrapf(err, "line %d: invalid replacement", numLine)
+		}
+
+		table[pua] = replacement
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, errors.Wrap(err, "failed to read the gaiji table")
+	}
+
+	return table, nil
+}
+
+// LoadFile is similar to Load but reads the mapping table from the given file
+// path.
+func LoadFile(pathFile string) (Table, error) {
+	ptrFile, err := os.Open(pathFile)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to open the gaiji table")
+	}
+
+	defer ptrFile.Close()
+
+	return Load(ptrFile)
+}
+
+// ----------------------------------------------------------------------------
+//  Methods
+// ----------------------------------------------------------------------------
+
+// Find returns the replacement string of the given PUA code point. The returned
+// boolean value indicates if the mapping was found.
+func (t Table) Find(pua rune) (string, bool) {
+	replacement, ok := t[pua]
+
+	return replacement, ok
+}
+
+// ----------------------------------------------------------------------------
+//  Private functions
+// ----------------------------------------------------------------------------
+
+// parseCodePoint parses a code point in hex such as "U+E000" or "E000".
+func parseCodePoint(field string) (rune, error) {
+	hexCode := strings.TrimPrefix(strings.ToUpper(field), "U+")
+
+	codePoint, err := strconv.ParseUint(hexCode, 16, 32)
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to parse %q", field)
+	}
+
+	if codePoint > unicode.MaxRune {
+		return 0, errors.Errorf("%q is out of range of Unicode", field)
+	}
+
+	return rune(codePoint), nil
+}
+
+// parseReplacement parses the replacement fields. A single field without the
+// "U+" prefix is used as is. Such as "髙" and "CAFE". Otherwise, all the fields
+// are parsed as code points.
+func parseReplacement(fields []string) (string, error) {
+	if len(fields) == 1 && !strings.HasPrefix(strings.ToUpper(fields[0]), "U+") {
+		return fields[0], nil
+	}
+
+	replacement := make([]rune, 0, len(fields))
+
+	for _, field := range fields {
+		codePoint, err := parseCodePoint(field)
+		if err != nil {
+			return "", err
+		}
+
+		replacement = append(replacement, codePoint)
+	}
+
+	return string(replacement), nil
+}
@@ -0,0 +1,116 @@
+package gaiji
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsPUA(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		char   rune
+		expect bool
+	}{
+		{0xE000, true},   // First of the Private Use Area
+		{0xF8FF, true},   // Last of the Private Use Area
+		{0xF0000, true},  // Supplementary Private Use Area-A
+		{0x10FFFD, true}, // Supplementary Private Use Area-B
+		{'漢', false},
+		{'a', false},
+	} {
+		require.Equal(t, test.expect, IsPUA(test.char), "IsPUA(%U) returned unexpected result", test.char)
+	}
+}
+
+func TestLoad_golden(t *testing.T) {
+	t.Parallel()
+
+	table, err := Load(strings.NewReader(heredoc.Doc(`
+		# Sample table
+		U+E000	U+9AD9
+		u+e001  髙  # lower case prefix
+		E002	U+30BB U+309A
+
+		F0000	ABC
+		E010	CAFE # words of hex letters are not code points
+		E011	U+CAFE
+	`)))
+
+	require.NoError(t, err)
+	require.Equal(t, Table{
+		0xE000:  "髙",
+		0xE001:  "髙",
+		0xE002:  "セ゚",
+		0xF0000: "ABC",
+		0xE010:  "CAFE",
+		0xE011:  "\uCAFE",
+	}, table)
+
+	replacement, ok := table.Find(0xE000)
+
+	require.True(t, ok)
+	require.Equal(t, "髙", replacement)
+
+	_, ok = table.Find(0xE003)
+
+	require.False(t, ok)
+}
+
+func TestLoad_invalid(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		input     string
+		expectErr string
+	}{
+		{"U+E000", "line 1: missing replacement for \"U+E000\""},
+		{"\nU+ZZZZ\t髙", "line 2: invalid code point"},
+		{"U+6F22\t漢", "line 1: U+6F22 is not in the Private Use Area"},
+		{"U+E000\tU+110000", "line 1: invalid replacement: \"U+110000\" is out of range of Unicode"},
+		{"U+E000\t髙 U+9AD9", "line 1: invalid replacement: failed to parse \"髙\""},
+	} {
+		table, err := Load(strings.NewReader(test.input))
+
+		require.Error(t, err, "input %q should fail", test.input)
+		assert.Contains(t, err.Error(), test.expectErr, "it should contain the error reason")
+		assert.Nil(t, table, "it should return nil on error")
+	}
+}
+
+func TestLoad_nil_input(t *testing.T) {
+	t.Parallel()
+
+	table, err := Load(nil)
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "input is nil")
+	assert.Nil(t, table)
+}
+
+func TestLoadFile(t *testing.T) {
+	t.Parallel()
+
+	pathFile := filepath.Join(t.TempDir(), "table.txt")
+
+	require.NoError(t, os.WriteFile(pathFile, []byte("U+E000 U+9AD9\n"), 0o600),
+		"failed to create test file during setup")
+
+	table, err := LoadFile(pathFile)
+
+	require.NoError(t, err)
+	require.Equal(t, Table{0xE000: "髙"}, table)
+
+	// Non existing file
+	table, err = LoadFile(filepath.Join(t.TempDir(), "unknown.txt"))
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to open the gaiji table")
+	assert.Nil(t, table)
+}
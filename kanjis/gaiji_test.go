@@ -0,0 +1,66 @@
+package kanjis
+
+import (
+	"bytes"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/KEINOS/go-joyokanjis/kanjis/converter"
+	"github.com/KEINOS/go-joyokanjis/kanjis/gaiji"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ----------------------------------------------------------------------------
+//  FixFileAsJoyoWithGaiji()
+// ----------------------------------------------------------------------------
+
+func TestFixFileAsJoyoWithGaiji_nil_output(t *testing.T) {
+	t.Parallel()
+
+	unmapped, err := FixFileAsJoyoWithGaiji(strings.NewReader(""), nil, gaiji.Table{})
+
+	require.Error(t, err, "nil output should return an error")
+	assert.Contains(t, err.Error(), "input or output is nil",
+		"it should contain the error reason")
+	assert.Nil(t, unmapped, "it should return nil on error")
+}
+
+func TestFixFileAsJoyoWithGaiji_fail_during_read(t *testing.T) {
+	t.Parallel()
+
+	input := &DummyReader{
+		Count:        0,
+		ErrorOnCount: 1,
+	}
+
+	var output bytes.Buffer
+
+	_, err := FixFileAsJoyoWithGaiji(input, &output, gaiji.Table{})
+
+	require.Error(t, err, "it should fail during the scan")
+	assert.Contains(t, err.Error(), "failed to convert the input to the output",
+		"it should contain the error reason")
+}
+
+// ----------------------------------------------------------------------------
+//  FixStringAsJoyoWithGaiji()
+// ----------------------------------------------------------------------------
+
+func TestFixStringAsJoyoWithGaiji(t *testing.T) {
+	t.Parallel()
+
+	table, err := gaiji.LoadFile(filepath.Join("testdata", "gaiji_sample.txt"))
+	require.NoError(t, err, "failed to load the test table")
+
+	input := "橋さんの字\nト\U000F0000"
+
+	output, unmapped := FixStringAsJoyoWithGaiji(input, table)
+
+	require.Equal(t, "髙橋さんの旧字\nセ゚ト\U000F0000", output)
+	require.Equal(t, []Unmapped{
+		{Pos: converter.Position{Offset: 22, Line: 2, Column: 1}, Char: 0xE0FF},
+		{Pos: converter.Position{Offset: 31, Line: 2, Column: 4}, Char: 0xF0000},
+	}, unmapped)
+}
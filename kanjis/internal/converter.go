@@ -4,6 +4,17 @@ This package generates the Joyo-kanji dictionary to be embedded in the package.
 It will download the dictionary in JSON and converts to a gob encoded format,
 then gzips it to be embedded in the package.
 
+The Jinmeiyo Kanji list (internal/data/jinmeiyo.txt) is converted in the same
+way as well.
+
+The optional sources below are read from internal/data if present. They are
+not downloaded and the steps of the missing ones are skipped, so the generation
+works offline.
+
+The code table of JIS X 0213 (kanji/jis_table.go) is generated as a Go source
+file from the JIS X 0213:2004 mapping table of x0213.org
+(jisx0213-2004-std.txt).
+
 To run/generate, use the following command from the root of the project:
 
 	go generate ./...
@@ -11,14 +22,21 @@ To run/generate, use the following command from the root of the project:
 package main
 
 import (
+	"bufio"
 	"bytes"
 	"compress/gzip"
 	"encoding/gob"
 	"fmt"
+	"go/format"
 	"io"
 	"net/http"
 	"os"
 	"path/filepath"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
 
 	"github.com/KEINOS/go-joyokanjis/kanjis/kanji"
 	"github.com/pkg/errors"
@@ -36,6 +54,13 @@ var (
 	pathGobOutput  string
 	pathGzipOutput string
 
+	pathJinmeiyoInput      string
+	pathJinmeiyoGobOutput  string
+	pathJinmeiyoGzipOutput string
+
+	pathJISInput  string
+	pathJISOutput string
+
 	levelCompress = levelCompressDefault
 )
 
@@ -44,6 +69,14 @@ func init() {
 	pathJSONInput = filepath.Join("internal", "json", "joyo2010.json")
 	pathGobOutput = filepath.Join("internal", "gob", "dict.gob")
 	pathGzipOutput = filepath.Join("internal", "gzgob", "dict.gzip")
+
+	pathJinmeiyoInput = filepath.Join("internal", "data", "jinmeiyo.txt")
+	pathJinmeiyoGobOutput = filepath.Join("internal", "gob", "jinmeiyo.gob")
+	pathJinmeiyoGzipOutput = filepath.Join("internal", "gzgob", "jinmeiyo.gzip")
+
+	pathJISInput = filepath.Join("internal", "data", "jisx0213-2004-std.txt")
+	pathJISOutput = filepath.Join("kanji", "jis_table.go")
+
 }
 
 func main() {
@@ -60,45 +93,61 @@ func main() {
 	dict, err := kanji.NewDict(dataJSON)
 	exitOnError(err)
 
-	// Convert the dictionary object to a gob encoded format.
-	buf := bytes.NewBuffer(nil)
-	exitOnError(gob.NewEncoder(buf).Encode(&dict))
+	// Add the additional old forms (kyujitai) that are not in the JSON.
+	addExtraKyuJitai(dict)
 
-	// Save the dictionary to a gob file.
-	exitOnError(os.WriteFile(pathGobOutput, buf.Bytes(), os.ModePerm))
+	// Save the dictionary as a gob file and its gzipped file.
+	exitOnError(saveGzipGob(dict, pathGobOutput, pathGzipOutput))
 
-	// Compress the gob file to a gz file.
-	ptrOut, err := os.Create(pathGzipOutput)
+	// Parse the Jinmeiyo Kanji list and save it as well.
+	dataJinmeiyo, err := os.ReadFile(pathJinmeiyoInput)
 	exitOnError(err)
 
-	gw, err := gzip.NewWriterLevel(ptrOut, levelCompress)
+	jinmeiyoDict, err := kanji.NewJinmeiyoDict(dataJinmeiyo)
 	exitOnError(err)
 
-	defer gw.Close()
-
-	// Write the compressed data to the file.
-	src, err := os.Open(pathGobOutput)
-	exitOnError(err)
+	exitOnError(saveGzipGob(jinmeiyoDict, pathJinmeiyoGobOutput, pathJinmeiyoGzipOutput))
 
-	defer src.Close()
+	// Read the JIS X 0213 mapping table if present and generate the code table.
+	if fileExists(pathJISInput) {
+		dataJIS, err := os.ReadFile(pathJISInput)
+		exitOnError(err)
 
-	_, err = io.Copy(gw, src)
-	exitOnError(err)
+		exitOnError(generateJISTable(dataJIS, pathJISOutput))
+	} else {
+		fmt.Println("Skip: JIS X 0213 mapping table not found:", pathJISInput)
+	}
 
 	fmt.Println("OK")
 }
 
+// addExtraKyuJitai adds the old forms in kanji.ExtraKyuJitaiMap to the given
+// dictionary. The Joyo Kanji that are not in the dictionary are skipped.
+func addExtraKyuJitai(dict *kanji.Dict) {
+	for shinJitai, forms := range kanji.ExtraKyuJitaiMap {
+		if err := dict.AddKyuJitai(shinJitai, forms...); err != nil {
+			fmt.Println("Skip:", err)
+		}
+	}
+}
+
 func downloadDictJSON(to string) error {
-	resp, err := http.Get(urlDictSource)
+	return downloadFile(urlDictSource, to, "the JSON dictionary")
+}
+
+// downloadFile downloads the file from the given URL and saves it to the given
+// path. The name is used for the error messages.
+func downloadFile(url, to, name string) error {
+	resp, err := http.Get(url)
 	if err != nil {
-		return errors.Wrap(err, "failed to download the JSON dictionary")
+		return errors.Wrap(err, "failed to download "+name)
 	}
 
 	defer resp.Body.Close()
 
 	out, err := os.Create(to)
 	if err != nil {
-		return errors.Wrap(err, "failed to create a file to save the JSON dictionary")
+		return errors.Wrap(err, "failed to create a file to save "+name)
 	}
 	defer out.Close()
 
@@ -106,6 +155,170 @@ func downloadDictJSON(to string) error {
 	return errors.Wrap(err, "failed to copy the downloaded data to the target file")
 }
 
+// generateJISTable parses the JIS X 0213 mapping table and generates the Go
+// source file of the code table to the given path.
+//
+// Each line of the mapping table is the JIS code with the plane prefix ("3-"
+// for plane 1 and "4-" for plane 2) and the Unicode code point separated by a
+// tab. Such as "3-2121\tU+3000". The reserved cells and the cells mapped to a
+// sequence of code points (such as "U+304B+309A") are left unassigned.
+func generateJISTable(data []byte, pathOut string) error {
+	var rows [2][94][94]rune
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+
+	for numLine := 1; scanner.Scan(); numLine++ {
+		line := scanner.Text()
+		if strings.TrimSpace(line) == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		fields := strings.Split(line, "\t")
+		if len(fields) < 2 {
+			return errors.Errorf("line %d: invalid format: %q", numLine, line)
+		}
+
+		plane, row, cell, err := parseJISCode(fields[0])
+		if err != nil {
+			return errors.Wrapf(err, "line %d", numLine)
+		}
+
+		codePoint := strings.TrimPrefix(fields[1], "U+")
+		if codePoint == "" || strings.Contains(codePoint, "+") {
+			continue
+		}
+
+		char, err := strconv.ParseUint(codePoint, 16, 32)
+		if err != nil {
+			return errors.Errorf("line %d: invalid code point: %q", numLine, fields[1])
+		}
+
+		rows[plane-1][row-1][cell-1] = rune(char)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return errors.Wrap(err, "failed to read the JIS X 0213 mapping table")
+	}
+
+	source, err := format.Source(renderJISTable(&rows))
+	if err != nil {
+		return errors.Wrap(err, "failed to format the JIS code table")
+	}
+
+	return errors.Wrap(os.WriteFile(pathOut, source, 0o644), "failed to save the JIS code table")
+}
+
+// parseJISCode parses the JIS code with the plane prefix. Such as "3-2121" to
+// plane 1, row 1 and cell 1.
+func parseJISCode(field string) (plane, row, cell int, err error) {
+	switch {
+	case strings.HasPrefix(field, "3-"):
+		plane = 1
+	case strings.HasPrefix(field, "4-"):
+		plane = 2
+	default:
+		return 0, 0, 0, errors.Errorf("unknown plane: %q", field)
+	}
+
+	code, err := strconv.ParseUint(field[2:], 16, 16)
+	if err != nil {
+		return 0, 0, 0, errors.Errorf("invalid JIS code: %q", field)
+	}
+
+	row, cell = int(code>>8)-0x20, int(code&0xFF)-0x20
+	if row < 1 || row > 94 || cell < 1 || cell > 94 {
+		return 0, 0, 0, errors.Errorf("JIS code out of range: %q", field)
+	}
+
+	return plane, row, cell, nil
+}
+
+// renderJISTable returns the unformatted Go source of the code table. The
+// unassigned cells are U+FFFD. The characters changed by the normalization
+// (such as the CJK Compatibility Ideographs) are escaped to avoid being
+// normalized by editors.
+func renderJISTable(rows *[2][94][94]rune) []byte {
+	var buf bytes.Buffer
+
+	buf.WriteString("// Code generated by internal/converter.go; DO NOT EDIT.\n\n")
+	buf.WriteString("package kanji\n\n")
+	buf.WriteString("// jisRows is the code table of JIS X 0213:2004. jisRows[p-1][r-1] is the\n")
+	buf.WriteString("// characters of the row r of the plane p in the order of the cell. The\n")
+	buf.WriteString("// unassigned cells are U+FFFD and the unused rows are empty.\n")
+	buf.WriteString("var jisRows = [2][94]string{\n")
+
+	for plane := range rows {
+		fmt.Fprintf(&buf, "{ // Plane %d\n", plane+1)
+
+		for row, cells := range rows[plane] {
+			if cells == [94]rune{} {
+				continue
+			}
+
+			fmt.Fprintf(&buf, "// Row %d-%d\n%d: \"", plane+1, row+1, row)
+
+			for _, char := range cells {
+				switch {
+				case char == 0:
+					buf.WriteString(`\uFFFD`)
+				case char == '"' || char == '\\' || !unicode.IsGraphic(char) || !norm.NFC.IsNormalString(string(char)):
+					if char > 0xFFFF {
+						fmt.Fprintf(&buf, `\U%08X`, char)
+					} else {
+						fmt.Fprintf(&buf, `\u%04X`, char)
+					}
+				default:
+					buf.WriteRune(char)
+				}
+			}
+
+			buf.WriteString("\",\n")
+		}
+
+		buf.WriteString("},\n")
+	}
+
+	buf.WriteString("}\n")
+
+	return buf.Bytes()
+}
+
+// saveGzipGob encodes the given object to a gob file and compresses it to a
+// gzip file.
+func saveGzipGob(obj any, pathGob, pathGzip string) error {
+	// Convert the object to a gob encoded format.
+	buf := bytes.NewBuffer(nil)
+	if err := gob.NewEncoder(buf).Encode(obj); err != nil {
+		return errors.Wrap(err, "failed to encode the object to gob")
+	}
+
+	// Save the object to a gob file.
+	if err := os.WriteFile(pathGob, buf.Bytes(), os.ModePerm); err != nil {
+		return errors.Wrap(err, "failed to save the gob file")
+	}
+
+	// Compress the gob data to a gz file.
+	ptrOut, err := os.Create(pathGzip)
+	if err != nil {
+		return errors.Wrap(err, "failed to create the gzip file")
+	}
+
+	defer ptrOut.Close()
+
+	gw, err := gzip.NewWriterLevel(ptrOut, levelCompress)
+	if err != nil {
+		return errors.Wrap(err, "failed to create the gzip writer")
+	}
+
+	if _, err := io.Copy(gw, buf); err != nil {
+		gw.Close()
+
+		return errors.Wrap(err, "failed to write the compressed data")
+	}
+
+	return errors.Wrap(gw.Close(), "failed to close the gzip writer")
+}
+
 // exitOnError exits the progrom if err is not nil. It will panic to let defer
 // functions run.
 func exitOnError(err error) {
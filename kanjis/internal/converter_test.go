@@ -52,6 +52,15 @@ func Test_main_golden(t *testing.T) {
 	pathJSONInput = filepath.Join(pathDirTmp, "joyo2010.json")
 	pathGobOutput = filepath.Join(pathDirTmp, "dict.gob")
 	pathGzipOutput = filepath.Join(pathDirTmp, "dict.gzip")
+	pathJinmeiyoInput = filepath.Join(pathDirTmp, "jinmeiyo.txt")
+	pathJinmeiyoGobOutput = filepath.Join(pathDirTmp, "jinmeiyo.gob")
+	pathJinmeiyoGzipOutput = filepath.Join(pathDirTmp, "jinmeiyo.gzip")
+	pathJISInput = filepath.Join(pathDirTmp, "jisx0213-2004-std.txt")
+	pathJISOutput = filepath.Join(pathDirTmp, "jis_table.go")
+
+	require.NoError(t, os.WriteFile(pathJinmeiyoInput, []byte("# Comment\n亘\n亙 亘\n亞 亜\n"), 0o600))
+	require.NoError(t, os.WriteFile(pathJISInput,
+		[]byte("## Comment\n3-3021\tU+4E9C\t# <cjk>\n3-2477\tU+304B+309A\n4-2D3C\tU+63F7\n"), 0o600))
 
 	out := capturer.CaptureStdout(func() {
 		require.NotPanics(t, func() {
@@ -90,6 +99,113 @@ func Test_main_golden(t *testing.T) {
 
 	// Check the parsed data
 	require.True(t, kanjiDict.IsJoyoKanji('𠮟'))
+
+	// Check the archived Jinmeiyo Kanji list
+	var jinmeiyoDict kanji.JinmeiyoDict
+
+	ptrFileJinmeiyo, err := os.Open(pathJinmeiyoGzipOutput)
+	require.NoError(t, err, "failed to open the Gzip file")
+
+	defer ptrFileJinmeiyo.Close()
+
+	err = tool.ExtractGzipGobToDict(ptrFileJinmeiyo, &jinmeiyoDict)
+	require.NoError(t, err, "failed to extract the Gzip file and assign to the JinmeiyoDict")
+
+	require.Equal(t, 3, jinmeiyoDict.Len())
+	require.True(t, jinmeiyoDict.IsJinmeiyoKanji('亞'))
+
+	// Check the generated JIS code table
+	jisTable, err := os.ReadFile(pathJISOutput)
+	require.NoError(t, err, "failed to read the generated JIS code table")
+
+	require.Contains(t, string(jisTable), "// Code generated by internal/converter.go; DO NOT EDIT.")
+	require.Contains(t, string(jisTable), "// Row 1-16\n\t\t15: \"亜\\uFFFD",
+		"the unassigned cells should be U+FFFD")
+	require.Contains(t, string(jisTable), "// Row 2-13\n\t\t12: \"",
+		"plane 2 should be in the second array")
+	require.NotContains(t, string(jisTable), "// Row 1-4\n",
+		"row of the sequences only should be omitted")
+}
+
+func Test_main_skip_missing_sources(t *testing.T) {
+	// Backup before mocking
+	backupAndDeferRestore(t)
+
+	// Mock the global variables. The optional sources do not exist.
+	pathDirTmp := t.TempDir()
+	pathJSONInput = filepath.Join(pathDirTmp, "joyo2010.json")
+	pathGobOutput = filepath.Join(pathDirTmp, "dict.gob")
+	pathGzipOutput = filepath.Join(pathDirTmp, "dict.gzip")
+	pathJinmeiyoInput = filepath.Join(pathDirTmp, "jinmeiyo.txt")
+	pathJinmeiyoGobOutput = filepath.Join(pathDirTmp, "jinmeiyo.gob")
+	pathJinmeiyoGzipOutput = filepath.Join(pathDirTmp, "jinmeiyo.gzip")
+	pathJISInput = filepath.Join(pathDirTmp, "jisx0213-2004-std.txt")
+	pathJISOutput = filepath.Join(pathDirTmp, "jis_table.go")
+
+	require.NoError(t, os.WriteFile(pathJSONInput, []byte(`{"134047": {"joyo_kanji": "𠮟"}}`), 0o600))
+	require.NoError(t, os.WriteFile(pathJinmeiyoInput, []byte("亘\n"), 0o600))
+
+	out := capturer.CaptureStdout(func() {
+		require.NotPanics(t, func() {
+			main()
+		})
+	})
+
+	require.NotContains(t, out, "Downloading", "it should not download the optional sources")
+	require.Contains(t, out, "Skip: JIS X 0213 mapping table not found:")
+
+	require.FileExists(t, pathGzipOutput)
+	require.NoFileExists(t, pathJISOutput, "the existing code table should be kept")
+}
+
+func Test_saveGzipGob_fail(t *testing.T) {
+	pathDirTmp := t.TempDir()
+
+	t.Run("unsupported type", func(t *testing.T) {
+		err := saveGzipGob(func() {}, filepath.Join(pathDirTmp, "a.gob"), filepath.Join(pathDirTmp, "a.gzip"))
+
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "failed to encode the object to gob")
+	})
+
+	t.Run("invalid gob path", func(t *testing.T) {
+		err := saveGzipGob(kanji.Dict{}, pathDirTmp, filepath.Join(pathDirTmp, "b.gzip"))
+
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "failed to save the gob file")
+	})
+
+	t.Run("invalid gzip path", func(t *testing.T) {
+		err := saveGzipGob(kanji.Dict{}, filepath.Join(pathDirTmp, "c.gob"), pathDirTmp)
+
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "failed to create the gzip file")
+	})
+}
+
+func Test_generateJISTable_fail(t *testing.T) {
+	pathOut := filepath.Join(t.TempDir(), "jis_table.go")
+
+	for _, test := range []struct {
+		input  string
+		expect string
+	}{
+		{input: "3-2121", expect: "line 1: invalid format"},
+		{input: "5-2121\tU+3000", expect: "line 1: unknown plane"},
+		{input: "3-ZZZZ\tU+3000", expect: "line 1: invalid JIS code"},
+		{input: "3-2120\tU+3000", expect: "line 1: JIS code out of range"},
+		{input: "\n3-2121\tU+ZZZZ", expect: "line 2: invalid code point"},
+	} {
+		err := generateJISTable([]byte(test.input), pathOut)
+
+		require.Error(t, err, "input: %q", test.input)
+		require.Contains(t, err.Error(), test.expect)
+	}
+
+	err := generateJISTable([]byte("3-2121\tU+3000"), t.TempDir())
+
+	require.Error(t, err, "directory as the output should fail")
+	require.Contains(t, err.Error(), "failed to save the JIS code table")
 }
 
 func Test_downloadDictJSON(t *testing.T) {
@@ -133,12 +249,22 @@ func backupAndDeferRestore(t *testing.T) {
 	oldPathJSONInput := pathJSONInput
 	oldPathGobOutput := pathGobOutput
 	oldPathGzipOutput := pathGzipOutput
+	oldPathJinmeiyoInput := pathJinmeiyoInput
+	oldPathJinmeiyoGobOutput := pathJinmeiyoGobOutput
+	oldPathJinmeiyoGzipOutput := pathJinmeiyoGzipOutput
+	oldPathJISInput := pathJISInput
+	oldPathJISOutput := pathJISOutput
 
 	t.Cleanup(func() {
 		urlDictSource = oldURLDictSource
 		pathJSONInput = oldPathJSONInput
 		pathGobOutput = oldPathGobOutput
 		pathGzipOutput = oldPathGzipOutput
+		pathJinmeiyoInput = oldPathJinmeiyoInput
+		pathJinmeiyoGobOutput = oldPathJinmeiyoGobOutput
+		pathJinmeiyoGzipOutput = oldPathJinmeiyoGzipOutput
+		pathJISInput = oldPathJISInput
+		pathJISOutput = oldPathJISOutput
 	})
 }
 
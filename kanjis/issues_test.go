@@ -6,6 +6,8 @@ import (
 	"github.com/stretchr/testify/assert"
 )
 
+// Joyo kanji with several old forms. Which are registered in the dictionary via
+// kanji.ExtraKyuJitaiMap.
 func Test_issue1(t *testing.T) {
 	for _, test := range []struct {
 		input  rune
@@ -17,11 +19,12 @@ func Test_issue1(t *testing.T) {
 		{'辨', '弁'},
 		{'辯', '弁'},
 		{'瓣', '弁'},
-		// Non joyo kanjis but in the kanji.NonJoyoOld2NewMap map
 		{'鬪', '闘'},
 		{'鬭', '闘'},
 	} {
 		assert.Equal(t, test.expect, FixRuneAsJoyo(test.input),
 			"'%q' should be converted to '%q'", string(test.input), string(test.expect))
+		assert.True(t, kanjiDict.IsKyuJitai(test.input),
+			"'%q' should be registered in the dictionary as an old kanji", string(test.input))
 	}
 }
@@ -252,3 +252,32 @@ func ExampleKanas_UnmarshalJSON() {
 	fmt.Println(parsedJSON.Data)
 	// Output: あいうえお
 }
+
+func ExampleIsHentaigana() {
+	for _, r := range []rune{'𛀂', '𛂞', 'あ', 'ア'} {
+		fmt.Printf("%s (%U): %v\n", string(r), r, kana.IsHentaigana(r))
+	}
+	// Output:
+	// 𛀂 (U+1B002): true
+	// 𛂞 (U+1B09E): true
+	// あ (U+3042): false
+	// ア (U+30A2): false
+}
+
+func ExampleToModernKana() {
+	for _, r := range []rune{
+		'𛀁', // HIRAGANA LETTER ARCHAIC YE
+		'𛀂', // HENTAIGANA LETTER A-1
+		'𛂞', // HENTAIGANA LETTER HA-1
+		'𛄠', // KATAKANA LETTER ARCHAIC YI
+		'あ', // Modern hiragana
+	} {
+		fmt.Printf("%s --> %s\n", string(r), string(kana.ToModernKana(r)))
+	}
+	// Output:
+	// 𛀁 --> え
+	// 𛀂 --> あ
+	// 𛂞 --> は
+	// 𛄠 --> イ
+	// あ --> あ
+}
@@ -1,5 +1,6 @@
 /*
 Package kana provides a type for Kana characters and functions for katakana and hiragana conversion.
+It also provides functions to convert hentaigana (変体仮名) and archaic kana to modern kana.
 */
 package kana
 
@@ -8,6 +9,15 @@ package kana
 // converted to each other by adjusting the difference.
 const codeDiff = 'ァ' - 'ぁ' // 0x60 = 0d96
 
+// Range of hentaigana (変体仮名) in the Kana Supplement and Kana Extended-A
+// blocks of Unicode.
+const (
+	// The first hentaigana, HENTAIGANA LETTER A-1.
+	minHentaigana = 0x1B002
+	// The last hentaigana, HENTAIGANA LETTER N-MU-MO-2.
+	maxHentaigana = 0x1B11E
+)
+
 // IsHiragana returns true if the rune is a Hiragana (hira-kana) that is convertable
 // to Katakana.
 //
@@ -17,6 +27,13 @@ func IsHiragana(r rune) bool {
 	return (r >= 'ぁ' && r <= 'ゖ')
 }
 
+// IsHentaigana returns true if the rune is a hentaigana (変体仮名), the variant
+// forms of hiragana used before the 1900 standardization. Such as '𛀂' (U+1B002,
+// HENTAIGANA LETTER A-1).
+func IsHentaigana(r rune) bool {
+	return r >= minHentaigana && r <= maxHentaigana
+}
+
 // IsKatakana returns true if the rune is a Katakana that is convertable to
 // Hiragana.
 //
@@ -36,6 +53,19 @@ func ToKatakana(r rune) rune {
 	return r
 }
 
+// ToModernKana converts the given hentaigana or archaic kana (U+1B000 - U+1B16F)
+// to the modern kana. E.g. '𛀁' (U+1B001) to 'え'.
+//
+// Hentaigana and archaic hiragana are converted to hiragana and archaic katakana
+// to katakana. If the given rune has no modern equivalent, then it returns as is.
+func ToModernKana(r rune) rune {
+	if modern, ok := hentaiganaMap[r]; ok {
+		return modern
+	}
+
+	return r
+}
+
 // ToHiragana converts the given Katakana rune to Hiragana.
 // If the given rune is not Katakana convertable, then it returns as is.
 func ToHiragana(r rune) rune {
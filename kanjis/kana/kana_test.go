@@ -0,0 +1,32 @@
+package kana
+
+import (
+	"testing"
+	"unicode"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// This test detects whether the values of hentaiganaMap are the modern kana.
+func Test_hentaiganaMap_values(t *testing.T) {
+	for key, val := range hentaiganaMap {
+		assert.True(t, key >= 0x1B000 && key <= 0x1B16F,
+			"hentaiganaMap key %U is out of range of the kana blocks", key)
+		assert.True(t, IsHiragana(val) || IsKatakana(val),
+			"hentaiganaMap value %s (%U) is not a modern kana", string(val), val)
+	}
+}
+
+// This test ensures all the hentaigana in the range are mapped.
+func TestIsHentaigana_all_mapped(t *testing.T) {
+	for r := rune(minHentaigana); r <= maxHentaigana; r++ {
+		assert.True(t, IsHentaigana(r), "%U should be a hentaigana", r)
+		assert.True(t, IsHiragana(ToModernKana(r)), "%U should be converted to hiragana", r)
+	}
+
+	assert.False(t, IsHentaigana(minHentaigana-1))
+	assert.False(t, IsHentaigana(maxHentaigana+1))
+	assert.False(t, IsHentaigana('あ'))
+	assert.True(t, unicode.Is(unicode.Hiragana, minHentaigana),
+		"hentaigana should be in the Hiragana script of Unicode")
+}
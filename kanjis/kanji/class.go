@@ -0,0 +1,78 @@
+package kanji
+
+import "unicode"
+
+// ----------------------------------------------------------------------------
+//  Type: Class
+// ----------------------------------------------------------------------------
+
+// Class is the classification of a character. Use Dict.Classify to get the
+// class of a character.
+type Class int
+
+const (
+	// ClassNotKanji is a character that is not a kanji. Such as kana and ASCII.
+	ClassNotKanji Class = iota
+	// ClassJoyo is a Joyo Kanji (常用漢字).
+	ClassJoyo
+	// ClassKyuJitai is an old kanji (旧字体) that has a new kanji (新字体).
+	ClassKyuJitai
+	// ClassSimplifiedChinese is a simplified Chinese character (简体字) that is
+	// not used in Japanese.
+	ClassSimplifiedChinese
+	// ClassHyogai is a kanji that is not a Joyo Kanji (表外漢字).
+	ClassHyogai
+)
+
+// String is a Stringer interface implementation.
+func (c Class) String() string {
+	switch c {
+	case ClassJoyo:
+		return "joyo"
+	case ClassKyuJitai:
+		return "kyujitai"
+	case ClassSimplifiedChinese:
+		return "simplified-chinese"
+	case ClassHyogai:
+		return "hyogai"
+	case ClassNotKanji:
+		return "not-kanji"
+	}
+
+	return "unknown"
+}
+
+// ----------------------------------------------------------------------------
+//  Functions
+// ----------------------------------------------------------------------------
+
+// IsSimplifiedChinese returns true if the given rune is a simplified Chinese
+// character (简体字) that is not used in Japanese. Such as '说' and '门'.
+//
+// Note that simplified Chinese characters which have the same form as Japanese
+// (such as '学' and '国') are not considered as simplified Chinese.
+func IsSimplifiedChinese(r rune) bool {
+	_, ok := SimplifiedChinese2JapaneseMap[r]
+
+	return ok
+}
+
+// SimplifiedToJapanese returns the Japanese form of the given simplified Chinese
+// character. E.g. '读' to '読'.
+//
+// The returned boolean value is false if the given rune is not a simplified
+// Chinese character or it has no Japanese equivalent.
+func SimplifiedToJapanese(r rune) (rune, bool) {
+	japanese, ok := SimplifiedChinese2JapaneseMap[r]
+	if !ok || japanese == 0 {
+		return r, false
+	}
+
+	return japanese, true
+}
+
+// isIdeograph returns true if the given rune is a kanji (CJK ideograph).
+// Including the CJK Compatibility Ideographs.
+func isIdeograph(r rune) bool {
+	return IsCJK(r) || unicode.Is(unicode.Ideographic, r)
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/KEINOS/go-joyokanjis/kanjis/kana"
 	"github.com/pkg/errors"
+	"golang.org/x/exp/slices"
 )
 
 // ----------------------------------------------------------------------------
@@ -44,26 +45,89 @@ func NewDict(jsonDict []byte) (*Dict, error) {
 //  Methods
 // ----------------------------------------------------------------------------
 
+// AddKyuJitai adds the given old forms (kyujitai) to the registered Joyo Kanji
+// and maps them to the dictionary as aliases.
+//
+// If the old form is already registered, only the note will be updated if the
+// given one is not empty.
+//
+// It returns an error and leaves the dictionary as is if an old form is a
+// registered Joyo Kanji or an old form of another Joyo Kanji. Such as '台' as
+// an old form of '弁'.
+func (d Dict) AddKyuJitai(shinJitai rune, forms ...OldForm) error {
+	tmpKanji, ok := d[shinJitai]
+	if !ok || tmpKanji.IsKyuJitai {
+		return errors.Errorf("%s (%U) is not a registered Joyo Kanji", string(shinJitai), shinJitai)
+	}
+
+	for _, form := range forms {
+		registered, ok := d[rune(form.Char)]
+		if !ok || (registered.IsKyuJitai && registered.ShinJitai == tmpKanji.ShinJitai) {
+			continue
+		}
+
+		if !registered.IsKyuJitai {
+			return errors.Errorf("%s (%U) is a registered Joyo Kanji and can not be an old form of %s",
+				form.Char, rune(form.Char), string(shinJitai))
+		}
+
+		return errors.Errorf("%s (%U) is already an old form of %s", form.Char, rune(form.Char),
+			registered.ShinJitai)
+	}
+
+	// Copy to not to modify the list shared with the existing aliases
+	newForms := append(OldForms{}, tmpKanji.KyuJitai...)
+
+	for _, form := range forms {
+		index := slices.IndexFunc(newForms, func(registered OldForm) bool {
+			return registered.Char == form.Char
+		})
+
+		switch {
+		case index < 0:
+			newForms = append(newForms, form)
+		case form.Note != "":
+			newForms[index].Note = form.Note
+		}
+	}
+
+	tmpKanji.KyuJitai = newForms
+	d[shinJitai] = tmpKanji
+
+	d.registerKyujitai(tmpKanji)
+
+	return nil
+}
+
 // appendKyujitai maps the Kyujitai (old kanjis) to the dictionary to speed up
 // the search. Searching with the old kanjis will return the same result as the
 // new kanjis.
 func (dict *Dict) appendKyujitai() {
 	// Add KyuJitai to the dictionary
 	for _, tmpKanji := range *dict {
-		if tmpKanji.KyuJitai == 0 {
+		if tmpKanji.IsKyuJitai {
 			continue
 		}
 
-		// Convert the KyuJitai to a rune
-		rKyuJitai := rune(tmpKanji.KyuJitai)
-
-		// Add the KyuJitai to the dictionary
-		if rKyuJitai != 0 {
-			tmpKanji.IsKyuJitai = true
+		dict.registerKyujitai(tmpKanji)
+	}
+}
 
-			(*dict)[rKyuJitai] = tmpKanji
-		}
+// Classify returns the classification of the given character. Such as Joyo Kanji,
+// old kanji (kyujitai), simplified Chinese and non-Joyo Kanji (hyogai kanji).
+func (d Dict) Classify(char rune) Class {
+	switch {
+	case d.IsJoyoKanji(char):
+		return ClassJoyo
+	case d.IsKyuJitai(char):
+		return ClassKyuJitai
+	case IsSimplifiedChinese(char):
+		return ClassSimplifiedChinese
+	case isIdeograph(char):
+		return ClassHyogai
 	}
+
+	return ClassNotKanji
 }
 
 // Find searches the given Kanji in the Joyo Kanji dictionary and returns the
@@ -120,7 +184,7 @@ func (d Dict) IsJoyoKanji(kanji rune) bool {
 // Note that it only detects if the old kanji is registered in the dictionary.
 func (d Dict) IsKyuJitai(kanji rune) bool {
 	if tmpKanji, ok := d[kanji]; ok {
-		return rune(tmpKanji.KyuJitai) == kanji
+		return tmpKanji.KyuJitai.Contains(kanji)
 	}
 
 	if _, ok := NonJoyoOld2NewMap[kanji]; ok {
@@ -130,6 +194,16 @@ func (d Dict) IsKyuJitai(kanji rune) bool {
 	return false
 }
 
+// KyuJitai returns the old forms (kyujitai) of the given Joyo Kanji. It returns
+// nil if the given kanji is not a Joyo Kanji or has no old form.
+func (d Dict) KyuJitai(kanji rune) OldForms {
+	if tmpKanji, ok := d[kanji]; ok && !tmpKanji.IsKyuJitai {
+		return tmpKanji.KyuJitai
+	}
+
+	return nil
+}
+
 // KunYomi returns the KunYomi reading in hiragana of the given Kanji.
 func (d Dict) KunYomi(kanji rune) []kana.Kanas {
 	if tmpKanji, ok := d[kanji]; ok {
@@ -183,6 +257,20 @@ func (d Dict) OnYomi(kanji rune) []kana.Kanas {
 	return nil
 }
 
+// registerKyujitai adds all the old forms of the given Joyo Kanji to the
+// dictionary as aliases.
+func (d Dict) registerKyujitai(joyoKanji Kanji) {
+	joyoKanji.IsKyuJitai = true
+
+	for _, form := range joyoKanji.KyuJitai {
+		if form.Char == 0 {
+			continue
+		}
+
+		d[rune(form.Char)] = joyoKanji
+	}
+}
+
 // stripKyuJitai removes the elements only for speeding up the search from the
 // Joyo Kanji dictionary. Elements such as ku_jitai as a key.
 func (d Dict) stripKyuJitai() Dict {
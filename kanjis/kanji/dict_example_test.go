@@ -12,6 +12,114 @@ import (
 //  Dict type
 // ============================================================================
 
+// ----------------------------------------------------------------------------
+//  Dict.AddKyuJitai()
+// ----------------------------------------------------------------------------
+
+func ExampleDict_AddKyuJitai() {
+	// Sample JSON dictionary.
+	// In this example only one kanji (new and old) is registered.
+	sampleJSON := `{
+		"24321": {
+			"joyo_kanji": "弁",
+			"kyu_jitai": "辨",
+			"yomi": {
+				"on_yomi": ["ベン"]
+			},
+			"raw_info": "弁\t辨\t5\t5\t\tベン"
+		}
+	}`
+
+	// Create a new dictionary from the JSON dictionary.
+	tmpDict, err := kanji.NewDict([]byte(sampleJSON))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	fmt.Println("Before:", string(tmpDict.FixAsJoyo('辯')), tmpDict.IsKyuJitai('辯'))
+
+	// '弁' has several old forms. Add them with notes.
+	err = tmpDict.AddKyuJitai('弁',
+		kanji.OldForm{Char: '辯', Note: "弁論"},
+		kanji.OldForm{Char: '瓣', Note: "花弁"},
+	)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	fmt.Println("After:", string(tmpDict.FixAsJoyo('辯')), tmpDict.IsKyuJitai('辯'))
+
+	if foundKanji, ok := tmpDict.Find('瓣'); ok {
+		fmt.Println("Old forms of 弁:", foundKanji.KyuJitai)
+
+		for _, form := range foundKanji.KyuJitai {
+			fmt.Printf("- %s: %q\n", form.Char, form.Note)
+		}
+	}
+
+	// The old forms are kept on marshaling
+	jsonDict, err := tmpDict.Marshal()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	fmt.Println(string(jsonDict))
+	// Output:
+	// Before: 辯 false
+	// After: 弁 true
+	// Old forms of 弁: 辨辯瓣
+	// - 辨: ""
+	// - 辯: "弁論"
+	// - 瓣: "花弁"
+	// {"24321":{"yomi":{"on_yomi":["ベン"]},"joyo_kanji":"弁","kyu_jitai":[{"char":"辨"},{"note":"弁論","char":"辯"},{"note":"花弁","char":"瓣"}]}}
+}
+
+// ----------------------------------------------------------------------------
+//  Dict.Classify()
+// ----------------------------------------------------------------------------
+
+func ExampleDict_Classify() {
+	// Sample JSON dictionary.
+	// In this example only one kanji (new and old) is registered.
+	sampleJSON := `{
+		"27005": {
+			"joyo_kanji": "楽",
+			"kyu_jitai": "樂",
+			"yomi": {
+				"on_yomi": [
+					"ガク",
+					"ラク"
+				],
+				"kun_yomi": [
+					"たの"
+				],
+				"example_yomi": [
+					"たの-しい",
+					"たの-しむ"
+				]
+			},
+			"raw_info": "楽\t樂\t13\t2\t\tガク、ラク、たの-しい、たの-しむ"
+		}
+	}`
+
+	// Create a new dictionary from the JSON dictionary.
+	tmpDict, err := kanji.NewDict([]byte(sampleJSON))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// Note that '忍' is a Joyo Kanji but not registered in this sample dictionary.
+	for _, r := range []rune{'楽', '樂', '乐', '忍', 'あ'} {
+		fmt.Printf("%s: %s\n", string(r), tmpDict.Classify(r))
+	}
+	// Output:
+	// 楽: joyo
+	// 樂: kyujitai
+	// 乐: simplified-chinese
+	// 忍: hyogai
+	// あ: not-kanji
+}
+
 // ----------------------------------------------------------------------------
 //  Dict.Find()
 // ----------------------------------------------------------------------------
@@ -216,6 +324,40 @@ func ExampleDict_IsKyuJitai() {
 	// Is '아' a kyu-jitai?: false
 }
 
+// ----------------------------------------------------------------------------
+//  Dict.KyuJitai()
+// ----------------------------------------------------------------------------
+
+func ExampleDict_KyuJitai() {
+	// Sample JSON dictionary.
+	sampleJSON := `{
+		"24321": {
+			"joyo_kanji": "弁",
+			"kyu_jitai": ["辨", "辯", "瓣"],
+			"yomi": {
+				"on_yomi": [
+					"ベン"
+				]
+			},
+			"raw_info": "弁\t辨\t5\t5\t\tベン"
+		}
+	}`
+
+	// Create a new dictionary from the JSON dictionary.
+	tmpDict, err := kanji.NewDict([]byte(sampleJSON))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	fmt.Printf("弁: %q\n", tmpDict.KyuJitai('弁'))
+	fmt.Printf("辯: %q\n", tmpDict.KyuJitai('辯')) // Old kanji has no old form
+	fmt.Printf("忍: %q\n", tmpDict.KyuJitai('忍')) // Not in the current dictionary
+	// Output:
+	// 弁: "辨辯瓣"
+	// 辯: ""
+	// 忍: ""
+}
+
 // ----------------------------------------------------------------------------
 //  Dict.KunYomi()
 // ----------------------------------------------------------------------------
@@ -449,3 +591,38 @@ func ExampleDict_OnYomi() {
 	// 肌: []
 	// 忍: []
 }
+
+// ----------------------------------------------------------------------------
+//  Dict.Readings()
+// ----------------------------------------------------------------------------
+
+func ExampleDict_Readings() {
+	// Sample JSON dictionary.
+	sampleJSON := `{
+		"27005": {
+			"joyo_kanji": "楽",
+			"kyu_jitai": "樂",
+			"yomi": {
+				"on_yomi": ["ガク", "ラク"],
+				"kun_yomi": ["たの"],
+				"example_yomi": ["たの-しい", "たの-しむ"]
+			}
+		}
+	}`
+
+	// Create a new dictionary from the JSON dictionary.
+	tmpDict, err := kanji.NewDict([]byte(sampleJSON))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	for _, reading := range tmpDict.Readings('樂') {
+		fmt.Printf("%s: stem=%s okurigana=%q (%s)\n",
+			reading.Kind, reading.Stem, reading.Okurigana, reading.Kana())
+	}
+	// Output:
+	// on: stem=ガク okurigana="" (ガク)
+	// on: stem=ラク okurigana="" (ラク)
+	// kun: stem=たの okurigana="しい" (たのしい)
+	// kun: stem=たの okurigana="しむ" (たのしむ)
+}
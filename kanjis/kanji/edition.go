@@ -0,0 +1,150 @@
+package kanji
+
+import "golang.org/x/exp/slices"
+
+// ----------------------------------------------------------------------------
+//  Type: Edition
+// ----------------------------------------------------------------------------
+
+// Edition is the edition of the official list of kanji for general use. Use
+// Dict.IsJoyoKanjiIn to check if a kanji is in the list of the edition.
+//
+// Note that the kanji are compared in the new forms (shinjitai) of the current
+// edition. Thus, the glyph differences between the editions are ignored.
+type Edition int
+
+const (
+	// Joyo2010 is the Joyo Kanji (常用漢字表) revised in 2010 with 2,136 kanji.
+	// It is the default and the current edition.
+	Joyo2010 Edition = iota
+	// Joyo1981 is the first Joyo Kanji (常用漢字表) of 1981 with 1,945 kanji.
+	Joyo1981
+	// Toyo1946 is the Toyo Kanji (当用漢字表) of 1946 with 1,850 kanji.
+	Toyo1946
+)
+
+// String is a Stringer interface implementation.
+func (e Edition) String() string {
+	switch e {
+	case Joyo2010:
+		return "joyo-2010"
+	case Joyo1981:
+		return "joyo-1981"
+	case Toyo1946:
+		return "toyo-1946"
+	}
+
+	return "unknown"
+}
+
+// ----------------------------------------------------------------------------
+//  Edition data
+// ----------------------------------------------------------------------------
+
+// addedIn1981 is the list of 95 kanji added to Toyo Kanji in the Joyo Kanji of
+// 1981.
+const addedIn1981 = "" +
+	"猿凹渦靴稼拐涯垣殻潟喝褐缶頑挟矯襟隅渓蛍嫌洪溝昆崎皿桟傘肢遮" +
+	"蛇酌汁塾尚宵縄壌唇甚据杉斉逝仙栓挿曹槽藻駄濯棚挑眺釣塚漬亭偵" +
+	"泥搭棟洞凸屯把覇漠肌鉢披扉猫頻瓶雰塀泡俸褒朴僕堀磨抹岬妄厄癒" +
+	"悠羅竜戻枠"
+
+// addedIn2010 is the list of 196 kanji added in the Joyo Kanji of 2010.
+const addedIn2010 = "" +
+	"挨曖宛嵐畏萎椅彙茨咽淫唄鬱怨媛艶旺岡臆俺苛牙瓦楷潰諧崖蓋骸柿" +
+	"顎葛釜鎌韓玩伎亀毀畿臼嗅巾僅錦惧串窟熊詣憬稽隙桁拳鍵舷股虎錮" +
+	"勾梗喉乞傲駒頃痕沙挫采塞埼柵刹拶斬恣摯餌鹿𠮟嫉腫呪袖羞蹴憧拭" +
+	"尻芯腎須裾凄醒脊戚煎羨腺詮箋膳狙遡曽爽痩踪捉遜汰唾堆戴誰旦綻" +
+	"緻酎貼嘲捗椎爪鶴諦溺塡妬賭藤瞳栃頓貪丼那奈梨謎鍋匂虹捻罵剝箸" +
+	"氾汎阪斑眉膝肘訃阜蔽餅璧蔑哺蜂貌頰睦勃昧枕蜜冥麺冶弥闇喩湧妖" +
+	"瘍沃拉辣藍璃慄侶瞭瑠呂賂弄籠麓脇"
+
+// removedIn2010 is the list of 5 kanji removed in the Joyo Kanji of 2010.
+const removedIn2010 = "勺錘銑脹匁"
+
+// Sets of the edition data.
+var (
+	addedIn1981Set   = newRuneSet(addedIn1981)
+	addedIn2010Set   = newRuneSet(addedIn2010)
+	removedIn2010Set = newRuneSet(removedIn2010)
+)
+
+// ----------------------------------------------------------------------------
+//  Methods of Dict
+// ----------------------------------------------------------------------------
+
+// DiffEditions returns the kanji added and removed from the "from" edition to
+// the "to" edition in ascending order of the code point. E.g. from Joyo1981 to
+// Joyo2010 returns the 196 added and the 5 removed kanji.
+func (d Dict) DiffEditions(from, to Edition) (added, removed []rune) {
+	for _, char := range d.editionCandidates() {
+		inFrom, inTo := d.IsJoyoKanjiIn(char, from), d.IsJoyoKanjiIn(char, to)
+
+		switch {
+		case !inFrom && inTo:
+			added = append(added, char)
+		case inFrom && !inTo:
+			removed = append(removed, char)
+		}
+	}
+
+	return added, removed
+}
+
+// IsJoyoKanjiIn returns true if the given kanji is in the list of the given
+// edition. IsJoyoKanjiIn(kanji, Joyo2010) is equivalent to IsJoyoKanji(kanji).
+func (d Dict) IsJoyoKanjiIn(kanji rune, edition Edition) bool {
+	switch edition {
+	case Joyo2010:
+		return d.IsJoyoKanji(kanji)
+	case Joyo1981:
+		if _, ok := removedIn2010Set[kanji]; ok {
+			return true
+		}
+
+		_, added := addedIn2010Set[kanji]
+
+		return !added && d.IsJoyoKanji(kanji)
+	case Toyo1946:
+		_, added := addedIn1981Set[kanji]
+
+		return !added && d.IsJoyoKanjiIn(kanji, Joyo1981)
+	}
+
+	return false
+}
+
+// editionCandidates returns the Joyo Kanji in the dictionary and the kanji
+// removed in 2010 in ascending order of the code point.
+func (d Dict) editionCandidates() []rune {
+	candidates := make([]rune, 0, len(d)+len(removedIn2010Set))
+
+	for char, tmpKanji := range d {
+		if !tmpKanji.IsKyuJitai {
+			candidates = append(candidates, char)
+		}
+	}
+
+	for char := range removedIn2010Set {
+		candidates = append(candidates, char)
+	}
+
+	slices.Sort(candidates)
+
+	return candidates
+}
+
+// ----------------------------------------------------------------------------
+//  Private functions
+// ----------------------------------------------------------------------------
+
+// newRuneSet returns a set of the characters in the given string.
+func newRuneSet(chars string) map[rune]struct{} {
+	set := make(map[rune]struct{}, len(chars))
+
+	for _, char := range chars {
+		set[char] = struct{}{}
+	}
+
+	return set
+}
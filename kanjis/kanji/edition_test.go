@@ -0,0 +1,35 @@
+package kanji
+
+import (
+	"testing"
+	"unicode/utf8"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEdition_String(t *testing.T) {
+	assert.Equal(t, "toyo-1946", Toyo1946.String())
+	assert.Equal(t, "joyo-1981", Joyo1981.String())
+	assert.Equal(t, "joyo-2010", Joyo2010.String())
+	assert.Equal(t, "unknown", Edition(-1).String())
+}
+
+// This test ensures the number of kanji in the edition data and that they do
+// not overlap.
+func Test_edition_data(t *testing.T) {
+	require.Equal(t, 95, utf8.RuneCountInString(addedIn1981))
+	require.Equal(t, 196, utf8.RuneCountInString(addedIn2010))
+	require.Equal(t, 5, utf8.RuneCountInString(removedIn2010))
+
+	require.Len(t, addedIn1981Set, 95, "duplicate kanji in addedIn1981")
+	require.Len(t, addedIn2010Set, 196, "duplicate kanji in addedIn2010")
+
+	for char := range addedIn2010Set {
+		_, found1981 := addedIn1981Set[char]
+		_, foundRemoved := removedIn2010Set[char]
+
+		assert.False(t, found1981, "%s is in both addedIn1981 and addedIn2010", string(char))
+		assert.False(t, foundRemoved, "%s is in both addedIn2010 and removedIn2010", string(char))
+	}
+}
@@ -3,6 +3,7 @@ package kanji_test
 import (
 	"fmt"
 	"log"
+	"regexp"
 
 	"github.com/KEINOS/go-joyokanjis/kanjis/kanji"
 )
@@ -111,3 +112,73 @@ func ExampleIsCJK() {
 	fmt.Println("OK")
 	// Output: OK
 }
+
+// ----------------------------------------------------------------------------
+//  IsSimplifiedChinese()
+// ----------------------------------------------------------------------------
+
+func ExampleIsSimplifiedChinese() {
+	for _, r := range []rune{'说', '门', '读', '们', '学', '読'} {
+		fmt.Printf("%s: %v\n", string(r), kanji.IsSimplifiedChinese(r))
+	}
+	// Output:
+	// 说: true
+	// 门: true
+	// 读: true
+	// 们: true
+	// 学: false
+	// 読: false
+}
+
+// ----------------------------------------------------------------------------
+//  SimplifiedToJapanese()
+// ----------------------------------------------------------------------------
+
+func ExampleSimplifiedToJapanese() {
+	for _, r := range []rune{'读', '说', '吗', '読'} {
+		japanese, ok := kanji.SimplifiedToJapanese(r)
+
+		fmt.Printf("%s -> %s (%v)\n", string(r), string(japanese), ok)
+	}
+	// Output:
+	// 读 -> 読 (true)
+	// 说 -> 説 (true)
+	// 吗 -> 吗 (false)
+	// 読 -> 読 (false)
+}
+
+func ExampleJISLevel() {
+	for _, char := range "亜弌俱𠂉あ" {
+		fmt.Printf("%s: %s\n", string(char), kanji.JISLevel(char))
+	}
+	// Output:
+	// 亜: level-1
+	// 弌: level-2
+	// 俱: level-3
+	// 𠂉: level-4
+	// あ: none
+}
+
+// This example decodes the gaiji annotations of Aozora Bunko, such as
+// "※(「韋＋備のつくり」、第3水準1-93-84)", to the characters.
+func ExampleParseKuten() {
+	input := "怪物は※(「韋＋備のつくり」、第3水準1-93-84)に風を送つてゐる"
+
+	annotation := regexp.MustCompile(`※\(「[^」]*」、第[34]水準(\d+-\d+-\d+)\)`)
+
+	output := annotation.ReplaceAllStringFunc(input, func(found string) string {
+		kuten, err := kanji.ParseKuten(annotation.FindStringSubmatch(found)[1])
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		if char, ok := kanji.FromKuten(kuten.Plane, kuten.Row, kuten.Cell); ok {
+			return string(char)
+		}
+
+		return found
+	})
+
+	fmt.Println(output)
+	// Output: 怪物は韛に風を送つてゐる
+}
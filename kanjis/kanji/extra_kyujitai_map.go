@@ -0,0 +1,26 @@
+package kanji
+
+// ExtraKyuJitaiMap is a key-value mapping for Joyo Kanji (shin-jitai) to its
+// additional old kanji forms ('kyu-jitai') which are not in the original Joyo
+// Kanji dictionary. Such as Joyo Kanji that has several old forms.
+//
+// The entries are added to the embedded dictionary on generation via
+// Dict.AddKyuJitai. If the old form is already registered, its note is updated.
+//
+// To add a new kanji, edit this file then run `go generate ./...`.
+var ExtraKyuJitaiMap = map[rune]OldForms{
+	'弁': {
+		{Char: '辨', Note: "弁別、弁償 (to distinguish)"},
+		{Char: '辯', Note: "弁論、雄弁 (to speak)"},
+		{Char: '瓣', Note: "花弁 (petal)"},
+	},
+	'台': {
+		{Char: '臺', Note: "台地、舞台 (stand)"},
+	},
+	'辺': {
+		{Char: '邉', Note: "邊の異体字 (variant of 邊)"},
+	},
+	'闘': {
+		{Char: '鬪', Note: "鬭の異体字 (variant of 鬭)"},
+	},
+}
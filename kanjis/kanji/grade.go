@@ -0,0 +1,137 @@
+package kanji
+
+import "strconv"
+
+// ----------------------------------------------------------------------------
+//  Type: Grade
+// ----------------------------------------------------------------------------
+
+// Grade is the school grade in which a Joyo Kanji is taught. 1 to 6 are the
+// grades of the elementary school for the Kyoiku Kanji (教育漢字). Use
+// Dict.GradeOf to get the grade of a kanji.
+type Grade int
+
+const (
+	// GradeNone is the grade of the characters that are not Joyo Kanji.
+	GradeNone Grade = 0
+	// GradeSecondary is the grade of the Joyo Kanji that are not Kyoiku Kanji.
+	// Which are taught in the secondary school (junior and senior high school).
+	GradeSecondary Grade = 7
+)
+
+// String is a Stringer interface implementation. E.g. "grade-1", "secondary"
+// and "none".
+func (g Grade) String() string {
+	switch {
+	case g == GradeNone:
+		return "none"
+	case g == GradeSecondary:
+		return "secondary"
+	case g >= 1 && g <= 6:
+		return "grade-" + strconv.Itoa(int(g))
+	}
+
+	return "unknown"
+}
+
+// IsKyoiku returns true if the grade is one of the elementary school grades.
+func (g Grade) IsKyoiku() bool {
+	return g >= 1 && g < GradeSecondary
+}
+
+// ----------------------------------------------------------------------------
+//  Grade data
+// ----------------------------------------------------------------------------
+
+// kyoikuKanji is the list of the Kyoiku Kanji per grade, with 1,026 kanji in
+// total. The source is the table of the kanji per grade (学年別漢字配当表) in the
+// appendix of the course of study for the elementary school (小学校学習指導要領)
+// by MEXT. The revision notified in 2017 (平成29年告示) and in effect since
+// April 2020.
+//
+// The revision moved the 20 kanji of the prefecture names (such as '茨' and
+// '岡') into grade 4 and moved some kanji among grades 4, 5 and 6.
+var kyoikuKanji = [...]string{
+	// Grade 1 (80 kanji)
+	"" +
+		"一右雨円王音下火花貝学気九休玉金空月犬見五口校左三山子四糸字耳七" +
+		"車手十出女小上森人水正生青夕石赤千川先早草足村大男竹中虫町天田土" +
+		"二日入年白八百文木本名目立力林六",
+	// Grade 2 (160 kanji)
+	"" +
+		"引羽雲園遠何科夏家歌画回会海絵外角楽活間丸岩顔汽記帰弓牛魚京強教" +
+		"近兄形計元言原戸古午後語工公広交光考行高黄合谷国黒今才細作算止市" +
+		"矢姉思紙寺自時室社弱首秋週春書少場色食心新親図数西声星晴切雪船線" +
+		"前組走多太体台地池知茶昼長鳥朝直通弟店点電刀冬当東答頭同道読内南" +
+		"肉馬売買麦半番父風分聞米歩母方北毎妹万明鳴毛門夜野友用曜来里理話",
+	// Grade 3 (200 kanji)
+	"" +
+		"悪安暗医委意育員院飲運泳駅央横屋温化荷界開階寒感漢館岸起期客究急" +
+		"級宮球去橋業曲局銀区苦具君係軽血決研県庫湖向幸港号根祭皿仕死使始" +
+		"指歯詩次事持式実写者主守取酒受州拾終習集住重宿所暑助昭消商章勝乗" +
+		"植申身神真深進世整昔全相送想息速族他打対待代第題炭短談着注柱丁帳" +
+		"調追定庭笛鉄転都度投豆島湯登等動童農波配倍箱畑発反坂板皮悲美鼻筆" +
+		"氷表秒病品負部服福物平返勉放味命面問役薬由油有遊予羊洋葉陽様落流" +
+		"旅両緑礼列練路和",
+	// Grade 4 (202 kanji)
+	"" +
+		"愛案以衣位茨印英栄媛塩岡億加果貨課芽賀改械害街各覚潟完官管関観願" +
+		"岐希季旗器機議求泣給挙漁共協鏡競極熊訓軍郡群径景芸欠結建健験固功" +
+		"好香候康佐差菜最埼材崎昨札刷察参産散残氏司試児治滋辞鹿失借種周祝" +
+		"順初松笑唱焼照城縄臣信井成省清静席積折節説浅戦選然争倉巣束側続卒" +
+		"孫帯隊達単置仲沖兆低底的典伝徒努灯働特徳栃奈梨熱念敗梅博阪飯飛必" +
+		"票標不夫付府阜富副兵別辺変便包法望牧末満未民無約勇要養浴利陸良料" +
+		"量輪類令冷例連老労録",
+	// Grade 5 (193 kanji)
+	"" +
+		"圧囲移因永営衛易益液演応往桜可仮価河過快解格確額刊幹慣眼紀基寄規" +
+		"喜技義逆久旧救居許境均禁句型経潔件険検限現減故個護効厚耕航鉱構興" +
+		"講告混査再災妻採際在財罪殺雑酸賛士支史志枝師資飼示似識質舎謝授修" +
+		"述術準序招証象賞条状常情織職制性政勢精製税責績接設絶祖素総造像増" +
+		"則測属率損貸態団断築貯張停提程適統堂銅導得毒独任燃能破犯判版比肥" +
+		"非費備評貧布婦武復複仏粉編弁保墓報豊防貿暴脈務夢迷綿輸余容略留領" +
+		"歴",
+	// Grade 6 (191 kanji)
+	"" +
+		"胃異遺域宇映延沿恩我灰拡革閣割株干巻看簡危机揮貴疑吸供胸郷勤筋系" +
+		"敬警劇激穴券絹権憲源厳己呼誤后孝皇紅降鋼刻穀骨困砂座済裁策冊蚕至" +
+		"私姿視詞誌磁射捨尺若樹収宗就衆従縦縮熟純処署諸除承将傷障蒸針仁垂" +
+		"推寸盛聖誠舌宣専泉洗染銭善奏窓創装層操蔵臓存尊退宅担探誕段暖値宙" +
+		"忠著庁頂腸潮賃痛敵展討党糖届難乳認納脳派拝背肺俳班晩否批秘俵腹奮" +
+		"並陛閉片補暮宝訪亡忘棒枚幕密盟模訳郵優預幼欲翌乱卵覧裏律臨朗論",
+}
+
+// kyoikuGrades is the map of the Kyoiku Kanji to their grades.
+var kyoikuGrades = func() map[rune]Grade {
+	grades := make(map[rune]Grade, 1026)
+
+	for i, chars := range kyoikuKanji {
+		for _, char := range chars {
+			grades[char] = Grade(i + 1)
+		}
+	}
+
+	return grades
+}()
+
+// ----------------------------------------------------------------------------
+//  Methods of Dict
+// ----------------------------------------------------------------------------
+
+// GradeOf returns the school grade in which the given kanji is taught. It is
+// 1 to 6 for the Kyoiku Kanji, GradeSecondary for the other Joyo Kanji and
+// GradeNone for the rest. Including the old forms (kyujitai) of Joyo Kanji.
+//
+// The grades are of the Kyoiku Kanji table (学年別漢字配当表) of the course of
+// study notified in 2017 and in effect since April 2020.
+func (d Dict) GradeOf(kanji rune) Grade {
+	if !d.IsJoyoKanji(kanji) {
+		return GradeNone
+	}
+
+	if grade, ok := kyoikuGrades[kanji]; ok {
+		return grade
+	}
+
+	return GradeSecondary
+}
@@ -0,0 +1,57 @@
+package kanji
+
+import (
+	"testing"
+	"unicode/utf8"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGrade_String(t *testing.T) {
+	assert.Equal(t, "none", GradeNone.String())
+	assert.Equal(t, "grade-1", Grade(1).String())
+	assert.Equal(t, "grade-6", Grade(6).String())
+	assert.Equal(t, "secondary", GradeSecondary.String())
+	assert.Equal(t, "unknown", Grade(8).String())
+	assert.Equal(t, "unknown", Grade(-1).String())
+}
+
+func TestGrade_IsKyoiku(t *testing.T) {
+	assert.False(t, GradeNone.IsKyoiku())
+	assert.True(t, Grade(1).IsKyoiku())
+	assert.True(t, Grade(6).IsKyoiku())
+	assert.False(t, GradeSecondary.IsKyoiku())
+}
+
+// This test ensures the number of kanji per grade of the 2020 revision and that
+// they do not overlap.
+func Test_kyoiku_data(t *testing.T) {
+	expect := []int{80, 160, 200, 202, 193, 191}
+
+	for i, chars := range kyoikuKanji {
+		require.Equal(t, expect[i], utf8.RuneCountInString(chars), "grade %d", i+1)
+	}
+
+	require.Len(t, kyoikuGrades, 1026, "duplicate kanji in kyoikuKanji")
+
+	// Prefecture name kanji moved to grade 4 in the 2020 revision
+	for _, char := range "茨媛岡潟岐熊香佐埼崎滋鹿縄井沖栃奈梨阪阜" {
+		assert.Equal(t, Grade(4), kyoikuGrades[char], "kanji: %s", string(char))
+	}
+}
+
+func TestDict_GradeOf(t *testing.T) {
+	dict := Dict{
+		'学': Kanji{ShinJitai: '学'},
+		'芸': Kanji{ShinJitai: '芸'},
+		'亜': Kanji{ShinJitai: '亜'},
+		'學': Kanji{IsKyuJitai: true, ShinJitai: '学'},
+	}
+
+	assert.Equal(t, Grade(1), dict.GradeOf('学'))
+	assert.Equal(t, Grade(4), dict.GradeOf('芸'))
+	assert.Equal(t, GradeSecondary, dict.GradeOf('亜'))
+	assert.Equal(t, GradeNone, dict.GradeOf('學'), "old forms should not have a grade")
+	assert.Equal(t, GradeNone, dict.GradeOf('薔'), "non-Joyo Kanji should not have a grade")
+}
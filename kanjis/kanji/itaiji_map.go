@@ -0,0 +1,32 @@
+package kanji
+
+// ItaijiMap is a key-value mapping for variant kanji (itaiji, 異体字) to its
+// standard form. Mostly the variants seen in personal and place names which are
+// neither old kanji (kyujitai) nor CJK compatibility ideographs.
+//
+// These are not converted by FixAsJoyo since they are different characters but
+// are considered equivalent when comparing strings. Such as names.
+//
+// To add a new kanji, edit this file.
+var ItaijiMap = map[rune]rune{
+	'髙':      '高',
+	'嵜':      '崎',
+	'\uFA11': '崎', // 﨑 (U+FA11) is a unified ideograph without decomposition
+	'𠮷':      '吉',
+	'嶋':      '島',
+	'嶌':      '島',
+	'峯':      '峰',
+	'冨':      '富',
+	'桒':      '桑',
+	'濵':      '浜',
+	'舘':      '館',
+	'曻':      '昇',
+	'篭':      '籠',
+	'穐':      '秋',
+	'龝':      '秋',
+	'邨':      '村',
+	'逹':      '達',
+	'凉':      '涼',
+	'盃':      '杯',
+	'煕':      '熙',
+}
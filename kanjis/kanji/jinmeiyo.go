@@ -0,0 +1,167 @@
+package kanji
+
+import (
+	"bufio"
+	"bytes"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+)
+
+// ----------------------------------------------------------------------------
+//  Type: Jinmeiyo
+// ----------------------------------------------------------------------------
+
+// Jinmeiyo is a struct that represents a Jinmeiyo Kanji (人名用漢字), the kanji
+// that can be used for personal names in addition to the Joyo Kanji.
+type Jinmeiyo struct {
+	// KyuJitai is the list of old kanji forms of the Jinmeiyo Kanji which are
+	// also Jinmeiyo Kanji. E.g. '亙' for '亘'.
+	KyuJitai OldForms `json:"kyu_jitai,omitempty"`
+	// ShinJitai is the new kanji form if the kanji is an old form (kyujitai).
+	// E.g. '亘' for '亙' and '亜' (Joyo Kanji) for '亞'. 0 if the kanji is not
+	// an old form.
+	ShinJitai KanjiChar `json:"shin_jitai,omitempty"`
+	// IsJoyoVariant is true if the kanji is an old form of a Joyo Kanji. Which
+	// is listed in the second part of the table (別表第二 二).
+	IsJoyoVariant bool `json:"is_joyo_variant,omitempty"`
+}
+
+// ----------------------------------------------------------------------------
+//  Type: JinmeiyoDict
+// ----------------------------------------------------------------------------
+
+// JinmeiyoDict is a map of Jinmeiyo objects. The key is the rune (int32) that
+// represents the kanji.
+type JinmeiyoDict map[rune]Jinmeiyo
+
+// ----------------------------------------------------------------------------
+//  Constructor
+// ----------------------------------------------------------------------------
+
+// NewJinmeiyoDict parses the text data of Jinmeiyo Kanji list to JinmeiyoDict
+// object.
+//
+// Each line is a kanji, or a kanji and its new form separated by white spaces
+// if the kanji is an old form (kyujitai). The new form that is not in the list
+// is considered as a Joyo Kanji. A kanji can be written in "U+XXXX" format.
+// Empty lines and lines starting with "#" are ignored.
+func NewJinmeiyoDict(data []byte) (*JinmeiyoDict, error) {
+	tmpDict := make(JinmeiyoDict)
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+
+	for numLine := 1; scanner.Scan(); numLine++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		fields := strings.Fields(line)
+		if len(fields) > 2 {
+			return nil, errors.Errorf("line %d: too many fields: %q", numLine, line)
+		}
+
+		chars := make([]rune, len(fields))
+
+		for i, field := range fields {
+			char, err := parseJinmeiyoField(field)
+			if err != nil {
+				return nil, errors.Wrapf(err, "line %d", numLine)
+			}
+
+			chars[i] = char
+		}
+
+		if _, ok := tmpDict[chars[0]]; ok {
+			return nil, errors.Errorf("line %d: duplicate kanji: %s (%U)", numLine, string(chars[0]), chars[0])
+		}
+
+		tmpJinmeiyo := Jinmeiyo{}
+		if len(chars) == 2 {
+			tmpJinmeiyo.ShinJitai = KanjiChar(chars[1])
+		}
+
+		tmpDict[chars[0]] = tmpJinmeiyo
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, errors.Wrap(err, "failed to read the Jinmeiyo Kanji list")
+	}
+
+	tmpDict.linkOldForms()
+
+	return &tmpDict, nil
+}
+
+// ----------------------------------------------------------------------------
+//  Methods
+// ----------------------------------------------------------------------------
+
+// Find returns the Jinmeiyo object of the given kanji. It returns false if the
+// kanji is not a Jinmeiyo Kanji.
+func (d JinmeiyoDict) Find(kanji rune) (Jinmeiyo, bool) {
+	tmpJinmeiyo, ok := d[kanji]
+
+	return tmpJinmeiyo, ok
+}
+
+// IsJinmeiyoKanji returns true if the given kanji is a Jinmeiyo Kanji. Including
+// the old forms of Joyo Kanji that can be used for personal names. E.g. '亞'.
+func (d JinmeiyoDict) IsJinmeiyoKanji(kanji rune) bool {
+	_, ok := d[kanji]
+
+	return ok
+}
+
+// Len returns the number of Jinmeiyo Kanji registered in the dictionary.
+func (d JinmeiyoDict) Len() int {
+	return len(d)
+}
+
+// linkOldForms sets the old forms to the new forms in the dictionary and marks
+// the old forms of Joyo Kanji, whose new form is not in the dictionary.
+func (d JinmeiyoDict) linkOldForms() {
+	for char, tmpJinmeiyo := range d {
+		if tmpJinmeiyo.ShinJitai == 0 {
+			continue
+		}
+
+		newForm, ok := d[rune(tmpJinmeiyo.ShinJitai)]
+		if !ok {
+			tmpJinmeiyo.IsJoyoVariant = true
+			d[char] = tmpJinmeiyo
+
+			continue
+		}
+
+		newForm.KyuJitai = append(newForm.KyuJitai, OldForm{Char: KanjiChar(char)})
+		d[rune(tmpJinmeiyo.ShinJitai)] = newForm
+	}
+}
+
+// ----------------------------------------------------------------------------
+//  Private functions
+// ----------------------------------------------------------------------------
+
+// parseJinmeiyoField parses a field of the Jinmeiyo Kanji list. The field is a
+// single character or a code point in "U+XXXX" format.
+func parseJinmeiyoField(field string) (rune, error) {
+	if utf8.RuneCountInString(field) == 1 {
+		char, _ := utf8.DecodeRuneInString(field)
+
+		return char, nil
+	}
+
+	if !strings.HasPrefix(strings.ToUpper(field), "U+") {
+		return 0, errors.Errorf("invalid kanji: %q", field)
+	}
+
+	codePoint, err := strconv.ParseUint(field[2:], 16, 32)
+	if err != nil || codePoint > utf8.MaxRune {
+		return 0, errors.Errorf("invalid code point: %q", field)
+	}
+
+	return rune(codePoint), nil
+}
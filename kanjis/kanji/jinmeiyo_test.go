@@ -0,0 +1,70 @@
+package kanji
+
+import (
+	"testing"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewJinmeiyoDict(t *testing.T) {
+	data := heredoc.Doc(`
+		# Comment line
+		亘
+		亙	亘
+
+		U+FA46	渚
+		渚
+		亞 亜
+	`)
+
+	dict, err := NewJinmeiyoDict([]byte(data))
+	require.NoError(t, err)
+
+	assert.Equal(t, 5, dict.Len())
+
+	// Old form of a Jinmeiyo Kanji
+	jinmeiyo, ok := dict.Find('亘')
+	require.True(t, ok)
+	assert.Equal(t, OldForms{{Char: '亙'}}, jinmeiyo.KyuJitai)
+	assert.Equal(t, KanjiChar(0), jinmeiyo.ShinJitai)
+
+	jinmeiyo, ok = dict.Find('亙')
+	require.True(t, ok)
+	assert.Equal(t, KanjiChar('亘'), jinmeiyo.ShinJitai)
+	assert.False(t, jinmeiyo.IsJoyoVariant)
+
+	// Code point format
+	jinmeiyo, ok = dict.Find('\uFA46')
+	require.True(t, ok)
+	assert.Equal(t, KanjiChar('渚'), jinmeiyo.ShinJitai)
+
+	// Old form of a Joyo Kanji
+	jinmeiyo, ok = dict.Find('亞')
+	require.True(t, ok)
+	assert.Equal(t, KanjiChar('亜'), jinmeiyo.ShinJitai)
+	assert.True(t, jinmeiyo.IsJoyoVariant)
+
+	assert.True(t, dict.IsJinmeiyoKanji('亞'))
+	assert.False(t, dict.IsJinmeiyoKanji('亜'))
+}
+
+func TestNewJinmeiyoDict_invalid(t *testing.T) {
+	for _, test := range []struct {
+		input     string
+		expectErr string
+	}{
+		{"亘\n亙 亘 亘", "line 2: too many fields"},
+		{"亘亙", `line 1: invalid kanji: "亘亙"`},
+		{"U+ZZZZ", `line 1: invalid code point: "U+ZZZZ"`},
+		{"U+FFFFFFFF", `line 1: invalid code point: "U+FFFFFFFF"`},
+		{"亘\n\n亘", "line 3: duplicate kanji: 亘 (U+4E98)"},
+	} {
+		dict, err := NewJinmeiyoDict([]byte(test.input))
+
+		require.Error(t, err, "input %q should fail", test.input)
+		require.Nil(t, dict)
+		assert.Contains(t, err.Error(), test.expectErr, "it should contain the error reason")
+	}
+}
@@ -0,0 +1,248 @@
+package kanji
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// Private global variables for the JIS X 0213 code table.
+var (
+	// jisCells is the decoded jisRows. jisCells[p-1][r-1][c-1] is the character
+	// of the cell c of the row r of the plane p. 0 if unassigned.
+	jisCells *[2][94][94]rune
+	// jisKutens is the map of the character to its kuten code.
+	jisKutens map[rune]Kuten
+	// onceJIS decodes the code table only once on the first use.
+	onceJIS sync.Once
+)
+
+// jisX0208Cells is the ranges of the assigned cells of JIS X 0208:1997 per row
+// other than the fully assigned rows (1, 16 to 46 and 48 to 83).
+var jisX0208Cells = map[int][][2]int{
+	2:  {{1, 14}, {26, 33}, {42, 48}, {60, 74}, {82, 89}, {94, 94}},
+	3:  {{16, 25}, {33, 58}, {65, 90}},
+	4:  {{1, 83}},
+	5:  {{1, 86}},
+	6:  {{1, 24}, {33, 56}},
+	7:  {{1, 33}, {49, 81}},
+	8:  {{1, 32}},
+	47: {{1, 51}},
+	84: {{1, 6}},
+}
+
+// ----------------------------------------------------------------------------
+//  Type: Level
+// ----------------------------------------------------------------------------
+
+// Level is the JIS kanji level (漢字水準). Levels 1 and 2 are the kanji of JIS
+// X 0208 and levels 3 and 4 are the kanji added in JIS X 0213. Use JISLevel to
+// get the level of a kanji.
+type Level int
+
+const (
+	// LevelNone is the level of the characters that are not kanji of JIS X 0213.
+	// Such as kana, symbols and the kanji not in the standard.
+	LevelNone Level = iota
+	// Level1 is the JIS level 1 kanji (第1水準). Rows 16 to 47 of JIS X 0208.
+	Level1
+	// Level2 is the JIS level 2 kanji (第2水準). Rows 48 to 84 of JIS X 0208.
+	Level2
+	// Level3 is the JIS level 3 kanji (第3水準). Kanji in plane 1 of JIS X 0213
+	// which are not in JIS X 0208.
+	Level3
+	// Level4 is the JIS level 4 kanji (第4水準). Kanji in plane 2 of JIS X 0213.
+	Level4
+)
+
+// String is a Stringer interface implementation. E.g. "level-1" and "none".
+func (l Level) String() string {
+	switch l {
+	case LevelNone:
+		return "none"
+	case Level1, Level2, Level3, Level4:
+		return "level-" + strconv.Itoa(int(l))
+	}
+
+	return "unknown"
+}
+
+// ----------------------------------------------------------------------------
+//  Type: Kuten
+// ----------------------------------------------------------------------------
+
+// Kuten is the men-ku-ten code (面区点番号) of a character in JIS X 0213. Which is
+// the plane (面), row (区) and cell (点) of the character in the code table. For
+// the characters of JIS X 0208, the plane is 1.
+type Kuten struct {
+	// Plane is the plane number. 1 or 2.
+	Plane int `json:"plane"`
+	// Row is the row number. 1 to 94.
+	Row int `json:"row"`
+	// Cell is the cell number. 1 to 94.
+	Cell int `json:"cell"`
+}
+
+// ParseKuten parses the men-ku-ten code in "plane-row-cell" format. Such as
+// "1-93-84" in the annotation of Aozora Bunko "第3水準1-93-84".
+//
+// It returns an error if the format is invalid or the code is out of range.
+// Note that it does not check if the cell is assigned. Use FromKuten for that.
+func ParseKuten(code string) (Kuten, error) {
+	fields := strings.Split(strings.TrimSpace(code), "-")
+	if len(fields) != 3 {
+		return Kuten{}, errors.Errorf("invalid men-ku-ten format: %q", code)
+	}
+
+	var numbers [3]int
+
+	for i, field := range fields {
+		number, err := strconv.Atoi(field)
+		if err != nil {
+			return Kuten{}, errors.Errorf("invalid men-ku-ten format: %q", code)
+		}
+
+		numbers[i] = number
+	}
+
+	kuten := Kuten{Plane: numbers[0], Row: numbers[1], Cell: numbers[2]}
+	if !kuten.IsValid() {
+		return Kuten{}, errors.Errorf("men-ku-ten out of range: %q", code)
+	}
+
+	return kuten, nil
+}
+
+// ----------------------------------------------------------------------------
+//  Methods
+// ----------------------------------------------------------------------------
+
+// IsJISX0208 returns true if the cell is assigned in JIS X 0208:1997. Which is
+// the character set of the strict Shift_JIS, EUC-JP and ISO-2022-JP without the
+// vendor extensions such as the NEC special characters in row 13.
+func (k Kuten) IsJISX0208() bool {
+	if k.Plane != 1 {
+		return false
+	}
+
+	if k.Row == 1 || (k.Row >= 16 && k.Row <= 46) || (k.Row >= 48 && k.Row <= 83) {
+		return k.Cell >= 1 && k.Cell <= 94
+	}
+
+	for _, cells := range jisX0208Cells[k.Row] {
+		if k.Cell >= cells[0] && k.Cell <= cells[1] {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsValid returns true if the plane, row and cell are in the range of the JIS
+// X 0213 code table.
+func (k Kuten) IsValid() bool {
+	return k.Plane >= 1 && k.Plane <= 2 &&
+		k.Row >= 1 && k.Row <= 94 &&
+		k.Cell >= 1 && k.Cell <= 94
+}
+
+// Level returns the JIS kanji level of the cell. It returns LevelNone if the
+// cell is not in the kanji area. Such as the kana and symbols.
+func (k Kuten) Level() Level {
+	if !k.IsValid() {
+		return LevelNone
+	}
+
+	if k.Plane == 2 {
+		return Level4
+	}
+
+	switch {
+	case k.Row >= 16 && k.Row <= 46, k.Row == 47 && k.Cell <= 51:
+		return Level1
+	case k.Row >= 48 && k.Row <= 83, k.Row == 84 && k.Cell <= 6:
+		return Level2
+	case k.Row == 14, k.Row == 15, k.Row >= 47:
+		return Level3
+	}
+
+	return LevelNone
+}
+
+// String is a Stringer interface implementation. It returns the code in
+// "plane-row-cell" format. E.g. "1-93-84".
+func (k Kuten) String() string {
+	return fmt.Sprintf("%d-%d-%d", k.Plane, k.Row, k.Cell)
+}
+
+// ----------------------------------------------------------------------------
+//  Public functions
+// ----------------------------------------------------------------------------
+
+// FromKuten returns the character of the given men-ku-ten code of JIS X 0213.
+// E.g. FromKuten(1, 93, 84) returns '韛'.
+//
+// It returns false if the code is out of range or unassigned. The cells mapped
+// to a sequence of characters (such as the kana with semi-voiced sound mark in
+// row 1-4) are considered unassigned as well.
+func FromKuten(plane, row, cell int) (rune, bool) {
+	if !(Kuten{Plane: plane, Row: row, Cell: cell}).IsValid() {
+		return 0, false
+	}
+
+	onceJIS.Do(decodeJISRows)
+
+	char := jisCells[plane-1][row-1][cell-1]
+
+	return char, char != 0
+}
+
+// JISLevel returns the JIS kanji level of the given kanji. E.g. Level1 for '亜'
+// and Level4 for '𠂉'. It returns LevelNone for the characters other than the
+// kanji of JIS X 0213.
+func JISLevel(char rune) Level {
+	kuten, ok := KutenOf(char)
+	if !ok {
+		return LevelNone
+	}
+
+	return kuten.Level()
+}
+
+// KutenOf returns the men-ku-ten code of the given character in JIS X 0213. It
+// returns false if the character is not in JIS X 0213.
+func KutenOf(char rune) (Kuten, bool) {
+	onceJIS.Do(decodeJISRows)
+
+	kuten, ok := jisKutens[char]
+
+	return kuten, ok
+}
+
+// ----------------------------------------------------------------------------
+//  Private functions
+// ----------------------------------------------------------------------------
+
+// decodeJISRows decodes the generated jisRows to jisCells and jisKutens.
+func decodeJISRows() {
+	jisCells = new([2][94][94]rune)
+	jisKutens = make(map[rune]Kuten, 11233)
+
+	for plane := range jisRows {
+		for row, chars := range jisRows[plane] {
+			cell := 0
+
+			for _, char := range chars {
+				if char != '\uFFFD' {
+					jisCells[plane][row][cell] = char
+					jisKutens[char] = Kuten{Plane: plane + 1, Row: row + 1, Cell: cell + 1}
+				}
+
+				cell++
+			}
+		}
+	}
+}
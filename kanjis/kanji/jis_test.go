@@ -0,0 +1,137 @@
+package kanji
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLevel_String(t *testing.T) {
+	assert.Equal(t, "none", LevelNone.String())
+	assert.Equal(t, "level-1", Level1.String())
+	assert.Equal(t, "level-4", Level4.String())
+	assert.Equal(t, "unknown", Level(5).String())
+}
+
+// This test ensures the number of kanji per level and the total number of the
+// characters in JIS X 0213:2004.
+func Test_jis_data(t *testing.T) {
+	onceJIS.Do(decodeJISRows)
+
+	counts := make(map[Level]int)
+
+	for _, kuten := range jisKutens {
+		counts[kuten.Level()]++
+	}
+
+	require.Len(t, jisKutens, 11233-25, "sequences of characters should be excluded")
+	assert.Equal(t, 2965, counts[Level1])
+	assert.Equal(t, 3390, counts[Level2])
+	assert.Equal(t, 1259, counts[Level3])
+	assert.Equal(t, 2436, counts[Level4])
+}
+
+// This test ensures the number of the characters in JIS X 0208:1997.
+func TestKuten_IsJISX0208(t *testing.T) {
+	onceJIS.Do(decodeJISRows)
+
+	count := 0
+
+	for _, kuten := range jisKutens {
+		if kuten.IsJISX0208() {
+			count++
+		}
+	}
+
+	require.Equal(t, 6879, count)
+
+	assert.True(t, Kuten{1, 2, 94}.IsJISX0208())  // ◯
+	assert.False(t, Kuten{1, 2, 93}.IsJISX0208()) // Added in JIS X 0213
+	assert.False(t, Kuten{1, 13, 1}.IsJISX0208()) // NEC special character
+	assert.False(t, Kuten{2, 1, 1}.IsJISX0208())  // Plane 2
+}
+
+func TestFromKuten(t *testing.T) {
+	for _, test := range []struct {
+		kuten  Kuten
+		expect rune
+		ok     bool
+	}{
+		{kuten: Kuten{1, 1, 1}, expect: '　', ok: true},
+		{kuten: Kuten{1, 1, 17}, expect: '‾', ok: true},  // OVERLINE (U+203E)
+		{kuten: Kuten{1, 1, 29}, expect: '—', ok: true},  // EM DASH (U+2014)
+		{kuten: Kuten{1, 1, 32}, expect: '\\', ok: true}, // REVERSE SOLIDUS (U+005C)
+		{kuten: Kuten{1, 1, 79}, expect: '¥', ok: true},  // YEN SIGN (U+00A5)
+		{kuten: Kuten{1, 16, 1}, expect: '亜', ok: true},
+		{kuten: Kuten{1, 93, 84}, expect: '韛', ok: true},
+		{kuten: Kuten{2, 1, 1}, expect: '𠂉', ok: true},
+		{kuten: Kuten{2, 13, 28}, expect: '揷', ok: true},
+		{kuten: Kuten{1, 4, 87}, ok: false}, // Sequence of characters (か゚)
+		{kuten: Kuten{1, 4, 94}, ok: false}, // Reserved
+		{kuten: Kuten{2, 2, 1}, ok: false},  // Unused row
+		{kuten: Kuten{0, 1, 1}, ok: false},  // Out of range
+		{kuten: Kuten{1, 95, 1}, ok: false}, // Out of range
+	} {
+		char, ok := FromKuten(test.kuten.Plane, test.kuten.Row, test.kuten.Cell)
+
+		require.Equal(t, test.ok, ok, "kuten: %s", test.kuten)
+		require.Equal(t, test.expect, char, "kuten: %s", test.kuten)
+	}
+}
+
+func TestKutenOf(t *testing.T) {
+	kuten, ok := KutenOf('韛')
+
+	require.True(t, ok)
+	require.Equal(t, "1-93-84", kuten.String())
+
+	_, ok = KutenOf('😀')
+	require.False(t, ok, "non-JIS character should return false")
+}
+
+// This test ensures that FromKuten and KutenOf are inverse functions of each
+// other.
+func TestKutenOf_round_trip(t *testing.T) {
+	for char, kuten := range jisKutens {
+		got, ok := FromKuten(kuten.Plane, kuten.Row, kuten.Cell)
+
+		require.True(t, ok)
+		require.Equal(t, char, got, "kuten: %s", kuten)
+	}
+}
+
+func TestJISLevel(t *testing.T) {
+	for _, test := range []struct {
+		char   rune
+		expect Level
+	}{
+		{char: '亜', expect: Level1},
+		{char: '腕', expect: Level1}, // 1-47-51
+		{char: '弌', expect: Level2},
+		{char: '熙', expect: Level2}, // 1-84-6
+		{char: '俱', expect: Level3},
+		{char: '韛', expect: Level3},
+		{char: '𠂉', expect: Level4},
+		{char: '揷', expect: Level4},
+		{char: '々', expect: LevelNone}, // Not in the kanji area
+		{char: 'あ', expect: LevelNone},
+		{char: '𠀋', expect: Level3}, // 1-14-2
+		{char: '😀', expect: LevelNone},
+	} {
+		assert.Equal(t, test.expect, JISLevel(test.char), "char: %s", string(test.char))
+	}
+}
+
+func TestParseKuten(t *testing.T) {
+	kuten, err := ParseKuten("1-93-84")
+
+	require.NoError(t, err)
+	require.Equal(t, Kuten{Plane: 1, Row: 93, Cell: 84}, kuten)
+
+	for _, input := range []string{"", "1-93", "1-93-84-1", "a-b-c", "3-1-1", "1-0-1", "1-1-95"} {
+		_, err := ParseKuten(input)
+
+		require.Error(t, err, "input: %q", input)
+	}
+}
@@ -32,8 +32,8 @@ type Kanji struct {
 	Yomi Yomi `json:"yomi,omitempty"`
 	// ShinJitai is the Joyo Kanji in new kanji form.
 	ShinJitai KanjiChar `json:"joyo_kanji,omitempty"`
-	// KyuJitai is the old kanji form which is mapped to the shinjitai.
-	KyuJitai KanjiChar `json:"kyu_jitai,omitempty"`
+	// KyuJitai is the list of old kanji forms which are mapped to the shinjitai.
+	KyuJitai OldForms `json:"kyu_jitai,omitempty"`
 	// IsKyuJitai is true if the map key is a KyuJitai.
 	IsKyuJitai bool `json:"-"`
 }
@@ -15,3 +15,61 @@ func Test_NonJoyoOld2NewMap_in_range(t *testing.T) {
 			"NonJoyoOld2NewMap value %s (%q) is not in range of IsCJK", string(val), val)
 	}
 }
+
+// This test detects whether the key of SimplifiedChinese2JapaneseMap is in the
+// range of IsCJK and is not a Joyo Kanji nor an old kanji.
+func Test_SimplifiedChinese2JapaneseMap_in_range(t *testing.T) {
+	for key, val := range SimplifiedChinese2JapaneseMap {
+		assert.True(t, IsCJK(key),
+			"SimplifiedChinese2JapaneseMap key %s (%q) is not in range of IsCJK", string(key), key)
+		assert.NotEqual(t, key, val,
+			"SimplifiedChinese2JapaneseMap key %s (%q) is mapped to itself", string(key), key)
+
+		_, isOld := NonJoyoOld2NewMap[key]
+		assert.False(t, isOld,
+			"SimplifiedChinese2JapaneseMap key %s (%q) is an old kanji", string(key), key)
+
+		if val != 0 {
+			assert.True(t, IsCJK(val),
+				"SimplifiedChinese2JapaneseMap value %s (%q) is not in range of IsCJK", string(val), val)
+		}
+	}
+}
+
+// This test ensures that the keys of SimplifiedChinese2JapaneseMap are not in
+// the JIS X 0213 code table. Which includes JIS X 0208.
+func Test_SimplifiedChinese2JapaneseMap_not_in_JIS(t *testing.T) {
+	for key := range SimplifiedChinese2JapaneseMap {
+		kuten, ok := KutenOf(key)
+
+		assert.False(t, ok,
+			"SimplifiedChinese2JapaneseMap key %s (%q) is in JIS X 0213 as %s", string(key), key, kuten)
+	}
+}
+
+func TestClass_String(t *testing.T) {
+	for _, test := range []struct {
+		class  Class
+		expect string
+	}{
+		{ClassNotKanji, "not-kanji"},
+		{ClassJoyo, "joyo"},
+		{ClassKyuJitai, "kyujitai"},
+		{ClassSimplifiedChinese, "simplified-chinese"},
+		{ClassHyogai, "hyogai"},
+		{Class(-1), "unknown"},
+	} {
+		assert.Equal(t, test.expect, test.class.String())
+	}
+}
+
+// This test detects whether the key of ShinJitaiAsOldFormMap is in the range of
+// IsCJK and has a note.
+func Test_ShinJitaiAsOldFormMap_in_range(t *testing.T) {
+	for key, val := range ShinJitaiAsOldFormMap {
+		assert.True(t, IsCJK(key),
+			"ShinJitaiAsOldFormMap key %s (%q) is not in range of IsCJK", string(key), key)
+		assert.NotEmpty(t, val,
+			"ShinJitaiAsOldFormMap key %s (%q) has no note", string(key), key)
+	}
+}
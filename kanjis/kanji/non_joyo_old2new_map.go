@@ -2,6 +2,8 @@ package kanji
 
 // NonJoyoOld2NewMap is a key-value mapping for old kanji ('kyu-jitai') to new kanji ('shin-jitai')
 // which are not in Joyo Kanji list.
+//
+// For the additional old forms of Joyo Kanji, edit `extra_kyujitai_map.go` instead.
 var NonJoyoOld2NewMap = map[rune]rune{
 	'亙': '亘',
 	'冱': '冴',
@@ -11,15 +13,11 @@ var NonJoyoOld2NewMap = map[rune]rune{
 	'晉': '晋',
 	'槇': '槙',
 	'瑤': '瑶',
-	'瓣': '弁',
 	'祿': '禄',
 	'穰': '穣',
 	'聰': '聡',
 	'萠': '萌',
 	'藪': '薮',
-	'辯': '弁',
 	'遙': '遥',
-	'邉': '辺',
-	'鬪': '闘',
 	'猪': '猪',
 }
@@ -0,0 +1,123 @@
+package kanji
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// ----------------------------------------------------------------------------
+//  Type: OldForm
+// ----------------------------------------------------------------------------
+
+// OldForm is an old kanji form (kyujitai, 旧字体) of a Joyo Kanji.
+type OldForm struct {
+	// Note is the note of the old form. Such as the usage of the form.
+	Note string `json:"note,omitempty"`
+	// Char is the old kanji.
+	Char KanjiChar `json:"char"`
+}
+
+// ----------------------------------------------------------------------------
+//  Type: OldForms
+// ----------------------------------------------------------------------------
+
+// OldForms is a list of old kanji forms (kyujitai, 旧字体) of a Joyo Kanji.
+//
+// Some Joyo Kanji have several old forms. For example, '弁' is the new form of
+// '辨', '辯' and '瓣'.
+//
+// In JSON, a single old form without a note is represented as a string (such
+// as "滯") to be compatible with the original format. Otherwise, it is a list
+// of objects with "char" and "note" elements.
+type OldForms []OldForm
+
+// ----------------------------------------------------------------------------
+//  Methods
+// ----------------------------------------------------------------------------
+
+// Contains returns true if the given rune is one of the old forms.
+func (o OldForms) Contains(char rune) bool {
+	for _, form := range o {
+		if rune(form.Char) == char {
+			return true
+		}
+	}
+
+	return false
+}
+
+// MarshalJSON is a Marshaler interface implementation.
+func (o OldForms) MarshalJSON() ([]byte, error) {
+	if len(o) == 1 && o[0].Note == "" {
+		return json.Marshal(o[0].Char)
+	}
+
+	// Cast to avoid recursion
+	return json.Marshal([]OldForm(o))
+}
+
+// String is a Stringer interface implementation. It returns the old forms as a
+// string. E.g. "辨辯瓣".
+func (o OldForms) String() string {
+	var result strings.Builder
+
+	for _, form := range o {
+		result.WriteRune(rune(form.Char))
+	}
+
+	return result.String()
+}
+
+// UnmarshalJSON is a Unmarshaler interface implementation. It accepts a string,
+// a list of strings and a list of objects.
+func (o *OldForms) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+
+	switch {
+	case len(data) == 0 || bytes.Equal(data, []byte("null")):
+		*o = nil
+
+		return nil
+	case data[0] == '"':
+		var char KanjiChar
+
+		if err := char.UnmarshalJSON(data); err != nil {
+			return err
+		}
+
+		*o = OldForms{{Char: char}}
+
+		return nil
+	}
+
+	var rawForms []json.RawMessage
+
+	if err := json.Unmarshal(data, &rawForms); err != nil {
+		return errors.Wrap(err, "failed to unmarshal the old forms")
+	}
+
+	forms := make(OldForms, 0, len(rawForms))
+
+	for _, rawForm := range rawForms {
+		var form OldForm
+
+		rawForm = bytes.TrimSpace(rawForm)
+
+		if len(rawForm) > 0 && rawForm[0] == '"' {
+			if err := form.Char.UnmarshalJSON(rawForm); err != nil {
+				return err
+			}
+		} else if err := json.Unmarshal(rawForm, &form); err != nil {
+			return errors.Wrap(err, "failed to unmarshal the old form")
+		}
+
+		forms = append(forms, form)
+	}
+
+	*o = forms
+
+	return nil
+}
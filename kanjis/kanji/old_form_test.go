@@ -0,0 +1,109 @@
+package kanji
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOldForms_UnmarshalJSON(t *testing.T) {
+	for _, test := range []struct {
+		input  string
+		expect OldForms
+	}{
+		{`"滯"`, OldForms{{Char: '滯'}}},
+		{`["辨", "辯"]`, OldForms{{Char: '辨'}, {Char: '辯'}}},
+		{`[{"char": "辨", "note": "弁別"}, "辯"]`, OldForms{{Char: '辨', Note: "弁別"}, {Char: '辯'}}},
+		{`null`, nil},
+	} {
+		var forms OldForms
+
+		require.NoError(t, json.Unmarshal([]byte(test.input), &forms),
+			"failed to unmarshal %s", test.input)
+		require.Equal(t, test.expect, forms, "unexpected result of %s", test.input)
+	}
+}
+
+func TestOldForms_UnmarshalJSON_invalid(t *testing.T) {
+	for _, test := range []struct {
+		input     string
+		expectErr string
+	}{
+		{`123`, "failed to unmarshal the old forms"},
+		{`[123]`, "failed to unmarshal the old form"},
+	} {
+		var forms OldForms
+
+		err := forms.UnmarshalJSON([]byte(test.input))
+
+		require.Error(t, err, "input %s should fail", test.input)
+		assert.Contains(t, err.Error(), test.expectErr, "it should contain the error reason")
+	}
+}
+
+func TestOldForms_round_trip(t *testing.T) {
+	for _, forms := range []OldForms{
+		{{Char: '滯'}},
+		{{Char: '辨', Note: "弁別"}},
+		{{Char: '辨'}, {Char: '辯', Note: "弁論"}, {Char: '瓣'}},
+	} {
+		data, err := json.Marshal(forms)
+		require.NoError(t, err)
+
+		var parsed OldForms
+
+		require.NoError(t, json.Unmarshal(data, &parsed))
+		require.Equal(t, forms, parsed, "round trip of %s failed", string(data))
+	}
+}
+
+func TestDict_AddKyuJitai_not_registered(t *testing.T) {
+	dict := Dict{}
+
+	err := dict.AddKyuJitai('弁', OldForm{Char: '辯'})
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "弁 (U+5F01) is not a registered Joyo Kanji")
+	assert.Empty(t, dict, "the dictionary should not be modified on error")
+}
+
+func TestDict_AddKyuJitai_conflict(t *testing.T) {
+	dict, err := NewDict([]byte(`{
+		"21488": {"joyo_kanji": "台", "kyu_jitai": "臺"},
+		"24321": {"joyo_kanji": "弁", "kyu_jitai": "辨"}
+	}`))
+	require.NoError(t, err)
+
+	for _, test := range []struct {
+		form      OldForm
+		expectErr string
+	}{
+		{form: OldForm{Char: '台'}, expectErr: "台 (U+53F0) is a registered Joyo Kanji and can not be an old form of 弁"},
+		{form: OldForm{Char: '臺'}, expectErr: "臺 (U+81FA) is already an old form of 台"},
+	} {
+		err := dict.AddKyuJitai('弁', OldForm{Char: '辯'}, test.form)
+
+		require.Error(t, err, "old form: %s", test.form.Char)
+		assert.Contains(t, err.Error(), test.expectErr)
+		assert.False(t, dict.IsKyuJitai('辯'), "the dictionary should not be modified on error")
+		assert.Equal(t, '台', dict.FixAsJoyo('臺'))
+	}
+
+	// The old forms of the same kanji are the ones already registered
+	require.NoError(t, dict.AddKyuJitai('弁', OldForm{Char: '辨', Note: "弁別"}))
+}
+
+// This test ensures that the keys of ExtraKyuJitaiMap do not overlap with the
+// NonJoyoOld2NewMap.
+func Test_ExtraKyuJitaiMap_not_in_NonJoyoOld2NewMap(t *testing.T) {
+	for shinJitai, forms := range ExtraKyuJitaiMap {
+		for _, form := range forms {
+			_, found := NonJoyoOld2NewMap[rune(form.Char)]
+
+			assert.False(t, found,
+				"old form %s of %s is also in NonJoyoOld2NewMap", form.Char, string(shinJitai))
+		}
+	}
+}
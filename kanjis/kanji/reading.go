@@ -0,0 +1,170 @@
+package kanji
+
+import (
+	"encoding/json"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/KEINOS/go-joyokanjis/kanjis/kana"
+	"github.com/pkg/errors"
+)
+
+// okuriganaSeparator is the separator between the stem and the okurigana in the
+// notation of the readings. E.g. "たの-しい".
+const okuriganaSeparator = "-"
+
+// ----------------------------------------------------------------------------
+//  Type: ReadingKind
+// ----------------------------------------------------------------------------
+
+// ReadingKind is the kind of a reading. Either on-yomi or kun-yomi.
+type ReadingKind int
+
+const (
+	// KindUnknown is the zero value of ReadingKind.
+	KindUnknown ReadingKind = iota
+	// KindOn is the "On" reading (on-yomi, "音読み"). E.g. "ガク".
+	KindOn
+	// KindKun is the "Kun" reading (kun-yomi, "訓読み"). E.g. "たの-しい".
+	KindKun
+)
+
+// String is a Stringer interface implementation. It returns "on", "kun" or
+// "unknown".
+func (k ReadingKind) String() string {
+	switch k {
+	case KindOn:
+		return "on"
+	case KindKun:
+		return "kun"
+	case KindUnknown:
+	}
+
+	return "unknown"
+}
+
+// ----------------------------------------------------------------------------
+//  Type: Reading
+// ----------------------------------------------------------------------------
+
+// Reading is a reading of a kanji with the okurigana (送り仮名) separated. Such
+// as "たの-しい" of '楽' which is the stem "たの" and the okurigana "しい".
+//
+// In JSON, it is a string in the notation of the readings of the Joyo Kanji
+// table. E.g. "たの-しい".
+type Reading struct {
+	// Stem is the part of the reading written with the kanji. E.g. "たの".
+	Stem kana.Kanas
+	// Okurigana is the part of the reading written in kana after the kanji.
+	// E.g. "しい". Empty if none.
+	Okurigana kana.Kanas
+	// Kind is the kind of the reading. Either KindOn or KindKun.
+	Kind ReadingKind
+}
+
+// ParseReading parses the reading in the notation of the Joyo Kanji table. The
+// okurigana is separated by a hyphen. E.g. "たの-しい".
+//
+// The reading in katakana is considered as an on-yomi and the others as a
+// kun-yomi.
+func ParseReading(notation string) Reading {
+	notation = strings.TrimSpace(notation)
+	stem, okurigana, _ := strings.Cut(notation, okuriganaSeparator)
+
+	kind := KindKun
+	if first, _ := utf8.DecodeRuneInString(notation); kana.IsKatakana(first) {
+		kind = KindOn
+	}
+
+	tmpReading := Reading{Stem: kana.Kanas(stem), Kind: kind}
+	if okurigana != "" {
+		tmpReading.Okurigana = kana.Kanas(okurigana)
+	}
+
+	return tmpReading
+}
+
+// ----------------------------------------------------------------------------
+//  Methods
+// ----------------------------------------------------------------------------
+
+// Kana returns the whole reading without the separator. E.g. "たのしい".
+func (r Reading) Kana() string {
+	return string(r.Stem) + string(r.Okurigana)
+}
+
+// MarshalJSON is a Marshaler interface implementation. It marshals the reading
+// in the notation of the Joyo Kanji table. E.g. "たの-しい".
+func (r Reading) MarshalJSON() ([]byte, error) {
+	byteJSON, err := json.Marshal(r.String())
+
+	return byteJSON, errors.Wrap(err, "failed to marshal the reading")
+}
+
+// String is a Stringer interface implementation. It returns the reading in the
+// notation of the Joyo Kanji table. E.g. "たの-しい".
+func (r Reading) String() string {
+	if len(r.Okurigana) == 0 {
+		return string(r.Stem)
+	}
+
+	return string(r.Stem) + okuriganaSeparator + string(r.Okurigana)
+}
+
+// UnmarshalJSON is a Unmarshaler interface implementation. See ParseReading for
+// the notation.
+func (r *Reading) UnmarshalJSON(data []byte) error {
+	var notation string
+
+	if err := json.Unmarshal(data, &notation); err != nil {
+		return errors.Wrap(err, "failed to unmarshal the reading")
+	}
+
+	*r = ParseReading(notation)
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+//  Methods of Dict
+// ----------------------------------------------------------------------------
+
+// Readings returns the readings of the given kanji. The on-yomi come first and
+// then the kun-yomi. E.g. "ガク", "ラク", "たの-しい" and "たの-しむ" for '楽'.
+//
+// The kun-yomi with okurigana replace the kun-yomi of the same stem. Thus "たの"
+// of '楽' is only returned as the stem of "たの-しい" and "たの-しむ". It
+// returns nil if the kanji is not registered.
+func (d Dict) Readings(kanji rune) []Reading {
+	tmpKanji, ok := d[kanji]
+	if !ok {
+		return nil
+	}
+
+	tmpYomi := tmpKanji.Yomi
+	readings := make([]Reading, 0, len(tmpYomi.OnYomi)+len(tmpYomi.KunYomi)+len(tmpYomi.ExampleYomi))
+	stems := make(map[string]bool, len(tmpYomi.ExampleYomi))
+
+	for _, onYomi := range tmpYomi.OnYomi {
+		readings = append(readings, Reading{Stem: onYomi, Kind: KindOn})
+	}
+
+	examples := tmpYomi.ExampleReadings()
+
+	for _, example := range examples {
+		stems[string(example.Stem)] = true
+	}
+
+	for _, kunYomi := range tmpYomi.KunYomi {
+		if !stems[string(kunYomi)] {
+			readings = append(readings, Reading{Stem: kunYomi, Kind: KindKun})
+		}
+	}
+
+	for _, example := range examples {
+		example.Kind = KindKun
+		readings = append(readings, example)
+	}
+
+	return readings
+}
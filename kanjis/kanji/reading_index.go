@@ -0,0 +1,228 @@
+package kanji
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/KEINOS/go-joyokanjis/kanjis/kana"
+)
+
+// ----------------------------------------------------------------------------
+//  Type: ReadingOption
+// ----------------------------------------------------------------------------
+
+// ReadingOption is the bit flags of the options for Dict.FindByReading and
+// ReadingIndex.Find. The options can be combined. E.g. ReadingKun|ReadingPrefix.
+type ReadingOption uint
+
+const (
+	// ReadingOn searches the on-yomi (音読み). E.g. "ガク".
+	ReadingOn ReadingOption = 1 << iota
+	// ReadingKun searches the kun-yomi (訓読み). E.g. "たの".
+	ReadingKun
+	// ReadingExample searches the example readings with okurigana. Such as
+	// "たの-しい". The hyphen between the stem and the okurigana is ignored,
+	// thus both "たのしい" and "たの-しい" match.
+	ReadingExample
+	// ReadingPrefix matches the readings that start with the given reading
+	// instead of the exact match.
+	ReadingPrefix
+	// ReadingFoldKana ignores the difference between hiragana and katakana.
+	// E.g. "がく" matches the on-yomi "ガク".
+	ReadingFoldKana
+)
+
+// readingKinds is the mask of the kinds of the readings to search. If none of
+// them is specified, all of them are searched.
+const readingKinds = ReadingOn | ReadingKun | ReadingExample
+
+// ----------------------------------------------------------------------------
+//  Type: ReadingIndex
+// ----------------------------------------------------------------------------
+
+// ReadingIndex is the reverse index of the readings to the Joyo Kanji. It is
+// immutable once created, thus safe for concurrent use.
+type ReadingIndex struct {
+	// entries is the list of the readings in ascending order of the reading.
+	entries []readingEntry
+	// folded is the same as entries but the readings are in hiragana.
+	folded []readingEntry
+}
+
+// readingEntry is an entry of the ReadingIndex.
+type readingEntry struct {
+	reading string
+	kind    ReadingOption
+	kanji   rune
+}
+
+// NewReadingIndex returns the reverse index of the readings of the Joyo Kanji
+// in the given dictionary. The old forms (kyujitai) are not indexed.
+//
+// Note that the changes made to the dictionary after creating the index are not
+// reflected to the index.
+func NewReadingIndex(dict Dict) *ReadingIndex {
+	index := new(ReadingIndex)
+
+	dict.eachReading(func(char rune, kind ReadingOption, reading string) {
+		index.entries = append(index.entries, readingEntry{reading: reading, kind: kind, kanji: char})
+		index.folded = append(index.folded, readingEntry{reading: foldReading(reading), kind: kind, kanji: char})
+	})
+
+	for _, entries := range [][]readingEntry{index.entries, index.folded} {
+		sort.Slice(entries, func(i, j int) bool { return entries[i].reading < entries[j].reading })
+	}
+
+	return index
+}
+
+// ----------------------------------------------------------------------------
+//  Methods
+// ----------------------------------------------------------------------------
+
+// Find returns the Joyo Kanji that have the given reading in ascending order
+// of the code point. See ReadingOption for the options.
+func (idx *ReadingIndex) Find(reading string, opts ReadingOption) []rune {
+	entries := idx.entries
+
+	reading = normalizeReading(reading)
+	if opts&ReadingFoldKana != 0 {
+		entries = idx.folded
+		reading = foldReading(reading)
+	}
+
+	if reading == "" {
+		return nil
+	}
+
+	found := make(map[rune]struct{})
+
+	start := sort.Search(len(entries), func(i int) bool { return entries[i].reading >= reading })
+
+	for _, entry := range entries[start:] {
+		if !matchReading(entry.reading, reading, opts) {
+			break
+		}
+
+		if entry.kind&kindsOf(opts) != 0 {
+			found[entry.kanji] = struct{}{}
+		}
+	}
+
+	return sortedRunes(found)
+}
+
+// ----------------------------------------------------------------------------
+//  Methods of Dict
+// ----------------------------------------------------------------------------
+
+// FindByReading returns the Joyo Kanji that have the given reading in ascending
+// order of the code point. E.g. "ガク" returns '学', '岳', '楽' and so on. See
+// ReadingOption for the options.
+//
+// Note that it does not keep an index. Each call scans all the readings of the
+// dictionary, which are about 5,300 for the Joyo Kanji, and folds each of them
+// if ReadingFoldKana is given. This takes milliseconds per call, about 2,000
+// times slower than ReadingIndex.Find. For repeated
+// lookups, create the index once with NewReadingIndex and use it instead. The
+// kanjis.FindByReading function does so.
+func (d Dict) FindByReading(reading string, opts ReadingOption) []rune {
+	reading = normalizeReading(reading)
+	if opts&ReadingFoldKana != 0 {
+		reading = foldReading(reading)
+	}
+
+	if reading == "" {
+		return nil
+	}
+
+	found := make(map[rune]struct{})
+
+	d.eachReading(func(char rune, kind ReadingOption, candidate string) {
+		if kind&kindsOf(opts) == 0 {
+			return
+		}
+
+		if opts&ReadingFoldKana != 0 {
+			candidate = foldReading(candidate)
+		}
+
+		if matchReading(candidate, reading, opts) {
+			found[char] = struct{}{}
+		}
+	})
+
+	return sortedRunes(found)
+}
+
+// eachReading calls fn for each reading of the Joyo Kanji in the dictionary.
+// The readings are normalized by normalizeReading.
+func (d Dict) eachReading(fn func(char rune, kind ReadingOption, reading string)) {
+	for char, tmpKanji := range d {
+		if !d.IsJoyoKanji(char) {
+			continue
+		}
+
+		for _, reading := range tmpKanji.Yomi.OnYomi {
+			fn(char, ReadingOn, normalizeReading(reading.String()))
+		}
+
+		for _, reading := range tmpKanji.Yomi.KunYomi {
+			fn(char, ReadingKun, normalizeReading(reading.String()))
+		}
+
+		for _, reading := range tmpKanji.Yomi.ExampleReadings() {
+			fn(char, ReadingExample, normalizeReading(reading.Kana()))
+		}
+	}
+}
+
+// ----------------------------------------------------------------------------
+//  Private functions
+// ----------------------------------------------------------------------------
+
+// foldReading returns the reading in hiragana.
+func foldReading(reading string) string {
+	return strings.Map(kana.ToHiragana, reading)
+}
+
+// kindsOf returns the kinds of the readings to search in the options.
+func kindsOf(opts ReadingOption) ReadingOption {
+	if kinds := opts & readingKinds; kinds != 0 {
+		return kinds
+	}
+
+	return readingKinds
+}
+
+// matchReading returns true if the candidate matches the reading by the options.
+func matchReading(candidate, reading string, opts ReadingOption) bool {
+	if opts&ReadingPrefix != 0 {
+		return strings.HasPrefix(candidate, reading)
+	}
+
+	return candidate == reading
+}
+
+// normalizeReading removes the hyphens between the stem and the okurigana and
+// the surrounding spaces. E.g. "たの-しい" to "たのしい".
+func normalizeReading(reading string) string {
+	return strings.ReplaceAll(strings.TrimSpace(reading), "-", "")
+}
+
+// sortedRunes returns the keys of the set in ascending order.
+func sortedRunes(set map[rune]struct{}) []rune {
+	if len(set) == 0 {
+		return nil
+	}
+
+	runes := make([]rune, 0, len(set))
+
+	for char := range set {
+		runes = append(runes, char)
+	}
+
+	sort.Slice(runes, func(i, j int) bool { return runes[i] < runes[j] })
+
+	return runes
+}
@@ -0,0 +1,87 @@
+package kanji
+
+import (
+	"testing"
+
+	"github.com/KEINOS/go-joyokanjis/kanjis/kana"
+	"github.com/stretchr/testify/assert"
+)
+
+// newReadingTestDict returns a small dictionary for the reading tests.
+func newReadingTestDict() Dict {
+	dict := Dict{
+		'学': Kanji{
+			ShinJitai: '学',
+			Yomi: Yomi{
+				OnYomi:      []kana.Kanas{kana.Kanas("ガク")},
+				KunYomi:     []kana.Kanas{kana.Kanas("まな")},
+				ExampleYomi: []string{"まな-ぶ"},
+			},
+		},
+		'楽': Kanji{
+			ShinJitai: '楽',
+			Yomi: Yomi{
+				OnYomi:      []kana.Kanas{kana.Kanas("ガク"), kana.Kanas("ラク")},
+				KunYomi:     []kana.Kanas{kana.Kanas("たの")},
+				ExampleYomi: []string{"たの-しい", "たの-しむ"},
+			},
+		},
+		'頼': Kanji{
+			ShinJitai: '頼',
+			Yomi: Yomi{
+				OnYomi:      []kana.Kanas{kana.Kanas("ライ")},
+				KunYomi:     []kana.Kanas{kana.Kanas("たの"), kana.Kanas("たよ")},
+				ExampleYomi: []string{"たの-む", "たの-もしい", "たよ-る"},
+			},
+		},
+	}
+
+	// Old forms should not be indexed
+	dict['學'] = Kanji{ShinJitai: '学', IsKyuJitai: true, Yomi: dict['学'].Yomi}
+
+	return dict
+}
+
+func TestDict_FindByReading(t *testing.T) {
+	dict := newReadingTestDict()
+	index := NewReadingIndex(dict)
+
+	for _, test := range []struct {
+		reading string
+		opts    ReadingOption
+		expect  []rune
+	}{
+		{reading: "ガク", opts: 0, expect: []rune{'学', '楽'}},
+		{reading: "がく", opts: 0, expect: nil},
+		{reading: "がく", opts: ReadingFoldKana, expect: []rune{'学', '楽'}},
+		{reading: "ガク", opts: ReadingKun, expect: nil},
+		{reading: "たの", opts: 0, expect: []rune{'楽', '頼'}},
+		{reading: "たの", opts: ReadingExample, expect: nil},
+		{reading: "たの", opts: ReadingExample | ReadingPrefix, expect: []rune{'楽', '頼'}},
+		{reading: "たのし", opts: ReadingPrefix, expect: []rune{'楽'}},
+		{reading: "たのしい", opts: 0, expect: []rune{'楽'}},
+		{reading: "たの-しい", opts: ReadingExample, expect: []rune{'楽'}},
+		{reading: "タノシイ", opts: ReadingFoldKana, expect: []rune{'楽'}},
+		{reading: "ラ", opts: ReadingOn | ReadingPrefix, expect: []rune{'楽', '頼'}},
+		{reading: "ラ", opts: ReadingOn, expect: nil},
+		{reading: "ら", opts: ReadingKun | ReadingPrefix | ReadingFoldKana, expect: nil},
+		{reading: "", opts: ReadingPrefix, expect: nil},
+		{reading: "-", opts: ReadingPrefix, expect: nil},
+	} {
+		assert.Equal(t, test.expect, dict.FindByReading(test.reading, test.opts),
+			"Dict.FindByReading(%q, %b)", test.reading, test.opts)
+		assert.Equal(t, test.expect, index.Find(test.reading, test.opts),
+			"ReadingIndex.Find(%q, %b)", test.reading, test.opts)
+	}
+}
+
+func TestNewReadingIndex_not_affected_by_changes(t *testing.T) {
+	dict := newReadingTestDict()
+	index := NewReadingIndex(dict)
+
+	delete(dict, '楽')
+
+	assert.Equal(t, []rune{'学'}, dict.FindByReading("ガク", 0))
+	assert.Equal(t, []rune{'学', '楽'}, index.Find("ガク", 0),
+		"changes to the dictionary should not be reflected to the index")
+}
@@ -0,0 +1,106 @@
+package kanji
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/KEINOS/go-joyokanjis/kanjis/kana"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseReading(t *testing.T) {
+	for _, test := range []struct {
+		notation string
+		expect   Reading
+	}{
+		{"たの-しい", Reading{Stem: kana.Kanas("たの"), Okurigana: kana.Kanas("しい"), Kind: KindKun}},
+		{"うえ", Reading{Stem: kana.Kanas("うえ"), Kind: KindKun}},
+		{" ガク ", Reading{Stem: kana.Kanas("ガク"), Kind: KindOn}},
+	} {
+		tmpReading := ParseReading(test.notation)
+
+		require.Equal(t, test.expect, tmpReading, "notation: %q", test.notation)
+	}
+}
+
+func TestReading_methods(t *testing.T) {
+	tmpReading := ParseReading("たの-しい")
+
+	assert.Equal(t, "たの-しい", tmpReading.String())
+	assert.Equal(t, "たのしい", tmpReading.Kana())
+	assert.Equal(t, "kun", tmpReading.Kind.String())
+
+	tmpReading = ParseReading("ガク")
+
+	assert.Equal(t, "ガク", tmpReading.String())
+	assert.Equal(t, "ガク", tmpReading.Kana())
+	assert.Equal(t, "on", tmpReading.Kind.String())
+
+	assert.Equal(t, "unknown", KindUnknown.String())
+	assert.Equal(t, "unknown", ReadingKind(99).String())
+}
+
+func TestReading_JSON(t *testing.T) {
+	input := `["たの-しい","ガク","\"うえ\""]`
+
+	var readings []Reading
+
+	require.NoError(t, json.Unmarshal([]byte(input), &readings))
+	require.Len(t, readings, 3)
+
+	assert.Equal(t, "しい", string(readings[0].Okurigana))
+	assert.Equal(t, KindOn, readings[1].Kind)
+
+	output, err := json.Marshal(readings)
+	require.NoError(t, err)
+
+	assert.Equal(t, input, string(output), "it should be compatible with the string notation")
+
+	err = json.Unmarshal([]byte(`[1]`), &readings)
+
+	require.Error(t, err, "non-string should fail")
+	assert.Contains(t, err.Error(), "failed to unmarshal the reading")
+}
+
+func TestYomi_ExampleReadings(t *testing.T) {
+	tmpYomi := Yomi{ExampleYomi: []string{"たの-しい", "たの-しむ"}}
+
+	assert.Equal(t, []Reading{
+		{Stem: kana.Kanas("たの"), Okurigana: kana.Kanas("しい"), Kind: KindKun},
+		{Stem: kana.Kanas("たの"), Okurigana: kana.Kanas("しむ"), Kind: KindKun},
+	}, tmpYomi.ExampleReadings())
+	assert.Equal(t, []string{"たの-しい", "たの-しむ"}, tmpYomi.ExampleYomi,
+		"the notations should be kept as is")
+
+	assert.Nil(t, Yomi{}.ExampleReadings())
+}
+
+func TestDict_Readings(t *testing.T) {
+	dict, err := NewDict([]byte(`{
+		"19978": {
+			"joyo_kanji": "上",
+			"yomi": {
+				"on_yomi": ["ジョウ", "ショウ"],
+				"kun_yomi": ["うえ", "あ", "のぼ"],
+				"example_yomi": ["あ-げる", "あ-がる", "のぼ-る"]
+			}
+		}
+	}`))
+	require.NoError(t, err)
+
+	readings := dict.Readings('上')
+
+	notations := make([]string, len(readings))
+	for i, tmpReading := range readings {
+		notations[i] = tmpReading.String()
+	}
+
+	assert.Equal(t, []string{"ジョウ", "ショウ", "うえ", "あ-げる", "あ-がる", "のぼ-る"}, notations,
+		"the stems of the readings with okurigana should not be duplicated")
+	assert.Equal(t, KindOn, readings[1].Kind)
+	assert.Equal(t, KindKun, readings[2].Kind)
+	assert.Equal(t, KindKun, readings[5].Kind)
+
+	assert.Nil(t, dict.Readings('下'), "unregistered kanji should return nil")
+}
@@ -0,0 +1,25 @@
+package kanji
+
+// ShinJitaiAsOldFormMap is a key-value mapping for Joyo Kanji (shin-jitai) that
+// also existed as a different kanji before the simplification, to the note of
+// its original meaning.
+//
+// For example, '芸' is the new form of '藝' but '芸' itself is an old kanji of a
+// fragrant herb (芸香). Thus, converting these kanji to the old forms is
+// ambiguous.
+//
+// To add a new kanji, edit this file.
+var ShinJitaiAsOldFormMap = map[rune]string{
+	'医': "医 (えい): quiver",
+	'予': "予 (よ): I, me",
+	'余': "余 (よ): I, me. E.g. 余輩",
+	'台': "台 (たい): used in 天台",
+	'弁': "弁 (べん): cap, crown",
+	'欠': "欠 (けん): yawn. E.g. 欠伸",
+	'灯': "灯 (てい): fierce fire",
+	'糸': "糸 (べき): fine thread",
+	'缶': "缶 (ふ): earthen jar",
+	'芸': "芸 (うん): fragrant herb. E.g. 芸香",
+	'虫': "虫 (き): viper",
+	'証': "証 (しょう): to remonstrate",
+}
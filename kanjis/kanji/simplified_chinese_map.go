@@ -0,0 +1,530 @@
+package kanji
+
+// SimplifiedChinese2JapaneseMap is a key-value mapping for simplified Chinese
+// characters (简体字) which are not used in Japanese to the Japanese form.
+//
+// The keys are the characters that are not in JIS X 0213 (thus not in JIS X
+// 0208 either) nor in CP932. Such as '开' (1-84-17) and '关' (2-3-8) are not
+// keys since they are used in Japanese as well. If the value is 0, the
+// character has no Japanese equivalent.
+//
+// To add a new character, edit this file.
+var SimplifiedChinese2JapaneseMap = map[rune]rune{
+	'东': '東',
+	'为': '為',
+	'乐': '楽',
+	'习': '習',
+	'乡': '郷',
+	'书': '書',
+	'买': '買',
+	'亏': '虧',
+	'亚': '亜',
+	'产': '産',
+	'亩': '畝',
+	'亲': '親',
+	'仅': '僅',
+	'仑': '侖',
+	'仓': '倉',
+	'仪': '儀',
+	'们': '們',
+	'众': '衆',
+	'优': '優',
+	'伟': '偉',
+	'传': '伝',
+	'伤': '傷',
+	'伦': '倫',
+	'佣': '傭',
+	'侣': '侶',
+	'侥': '僥',
+	'侦': '偵',
+	'侧': '側',
+	'侨': '僑',
+	'俭': '倹',
+	'债': '債',
+	'倾': '傾',
+	'偿': '償',
+	'兑': '兌',
+	'兰': '蘭',
+	'兴': '興',
+	'兹': '茲',
+	'兽': '獣',
+	'冈': '岡',
+	'军': '軍',
+	'农': '農',
+	'冯': '馮',
+	'冻': '凍',
+	'净': '浄',
+	'减': '減',
+	'凤': '鳳',
+	'凯': '凱',
+	'击': '撃',
+	'刘': '劉',
+	'则': '則',
+	'刚': '剛',
+	'创': '創',
+	'删': '刪',
+	'别': '別',
+	'剂': '剤',
+	'剑': '剣',
+	'剧': '劇',
+	'劝': '勧',
+	'办': '弁',
+	'务': '務',
+	'动': '動',
+	'劳': '労',
+	'势': '勢',
+	'勋': '勲',
+	'华': '華',
+	'协': '協',
+	'单': '単',
+	'卖': '売',
+	'卢': '盧',
+	'卫': '衛',
+	'厅': '庁',
+	'历': '歴',
+	'压': '圧',
+	'厌': '厭',
+	'厕': '廁',
+	'厢': '廂',
+	'县': '県',
+	'叁': '参',
+	'发': '発',
+	'变': '変',
+	'叠': '畳',
+	'叹': '嘆',
+	'吓': '嚇',
+	'吕': '呂',
+	'吗': 0,
+	'启': '啓',
+	'吴': '呉',
+	'呐': '吶',
+	'员': '員',
+	'呜': '嗚',
+	'响': '響',
+	'哑': '唖',
+	'哗': '嘩',
+	'唤': '喚',
+	'啰': 0,
+	'喷': '噴',
+	'团': '団',
+	'园': '園',
+	'围': '囲',
+	'图': '図',
+	'圆': '円',
+	'场': '場',
+	'块': '塊',
+	'坚': '堅',
+	'坛': '壇',
+	'坝': 0,
+	'坟': '墳',
+	'坠': '墜',
+	'垄': '壟',
+	'垒': '塁',
+	'垦': '墾',
+	'埚': '堝',
+	'墙': '牆',
+	'处': '処',
+	'备': '備',
+	'够': 0,
+	'头': '頭',
+	'夹': '夾',
+	'夺': '奪',
+	'奋': '奮',
+	'奖': '獎',
+	'妆': '妝',
+	'妇': '婦',
+	'妈': '媽',
+	'娄': '婁',
+	'娱': '娯',
+	'婴': '嬰',
+	'婶': 0,
+	'孙': '孫',
+	'实': '実',
+	'宠': '寵',
+	'审': '審',
+	'宪': '憲',
+	'宫': '宮',
+	'宽': '寛',
+	'宾': '賓',
+	'对': '対',
+	'寻': '尋',
+	'导': '導',
+	'尔': '爾',
+	'尘': '塵',
+	'尝': '嘗',
+	'层': '層',
+	'屿': '嶼',
+	'岁': '歳',
+	'岂': '豈',
+	'岗': '崗',
+	'岛': '島',
+	'币': '幣',
+	'帅': '帥',
+	'师': '師',
+	'帐': '帳',
+	'带': '帯',
+	'庆': '慶',
+	'库': '庫',
+	'应': '応',
+	'废': '廃',
+	'张': '張',
+	'弹': '弾',
+	'强': '強',
+	'归': '帰',
+	'录': '録',
+	'彻': '徹',
+	'忆': '憶',
+	'忧': '憂',
+	'怀': '懐',
+	'态': '態',
+	'总': '総',
+	'恶': '悪',
+	'悬': '懸',
+	'惊': '驚',
+	'惯': '慣',
+	'愤': '憤',
+	'懒': '懶',
+	'戏': '戯',
+	'战': '戦',
+	'户': '戸',
+	'执': '執',
+	'扩': '拡',
+	'扫': '掃',
+	'扬': '揚',
+	'扰': '擾',
+	'抚': '撫',
+	'抢': '搶',
+	'护': '護',
+	'报': '報',
+	'拟': '擬',
+	'拥': '擁',
+	'拦': 0,
+	'择': '択',
+	'挤': '擠',
+	'挥': '揮',
+	'损': '損',
+	'捡': 0,
+	'换': '換',
+	'掷': '擲',
+	'揽': '攬',
+	'搀': 0,
+	'摄': '摂',
+	'摆': '擺',
+	'摇': '揺',
+	'敌': '敵',
+	'斋': '斎',
+	'时': '時',
+	'显': '顕',
+	'晓': '暁',
+	'暂': '暫',
+	'术': '術',
+	'杀': '殺',
+	'杂': '雑',
+	'权': '権',
+	'杨': '楊',
+	'构': '構',
+	'枪': '槍',
+	'柜': '櫃',
+	'标': '標',
+	'栏': '欄',
+	'树': '樹',
+	'样': '様',
+	'桥': '橋',
+	'检': '検',
+	'欢': '歓',
+	'毁': '毀',
+	'毕': '畢',
+	'毙': '斃',
+	'汇': '匯',
+	'汉': '漢',
+	'汤': '湯',
+	'沟': '溝',
+	'泽': '沢',
+	'洁': '潔',
+	'测': '測',
+	'济': '済',
+	'浓': '濃',
+	'润': '潤',
+	'涨': '漲',
+	'渐': '漸',
+	'滚': '滾',
+	'满': '満',
+	'滤': '濾',
+	'滥': '濫',
+	'灭': '滅',
+	'炼': '煉',
+	'烛': '燭',
+	'烦': '煩',
+	'烧': '焼',
+	'热': '熱',
+	'焕': '煥',
+	'爱': '愛',
+	'爷': '爺',
+	'牵': '牽',
+	'狮': '獅',
+	'猎': '猟',
+	'环': '環',
+	'现': '現',
+	'玺': '璽',
+	'电': '電',
+	'畅': '暢',
+	'疗': '療',
+	'疮': '瘡',
+	'疯': '瘋',
+	'盐': '塩',
+	'监': '監',
+	'盘': '盤',
+	'矿': '礦',
+	'码': '碼',
+	'砖': '磚',
+	'础': '礎',
+	'硕': '碩',
+	'祸': '禍',
+	'积': '積',
+	'稳': '穏',
+	'穷': '窮',
+	'竞': '競',
+	'笔': '筆',
+	'笼': '籠',
+	'签': '簽',
+	'简': '簡',
+	'类': '類',
+	'紧': '緊',
+	'红': '紅',
+	'约': '約',
+	'级': '級',
+	'纪': '紀',
+	'纯': '純',
+	'纲': '綱',
+	'纳': '納',
+	'纵': '縦',
+	'纷': '紛',
+	'纸': '紙',
+	'纺': '紡',
+	'线': '線',
+	'练': '練',
+	'组': '組',
+	'细': '細',
+	'织': '織',
+	'终': '終',
+	'绍': '紹',
+	'经': '経',
+	'结': '結',
+	'绘': '絵',
+	'给': '給',
+	'绝': '絶',
+	'统': '統',
+	'绩': '績',
+	'续': '続',
+	'维': '維',
+	'综': '綜',
+	'绿': '緑',
+	'编': '編',
+	'缘': '縁',
+	'缩': '縮',
+	'肃': '粛',
+	'胜': '勝',
+	'胶': '膠',
+	'脏': '臓',
+	'脑': '脳',
+	'舰': '艦',
+	'艺': '芸',
+	'节': '節',
+	'苏': '蘇',
+	'药': '薬',
+	'莲': '蓮',
+	'获': '獲',
+	'虽': '雖',
+	'虾': '蝦',
+	'蚀': '蝕',
+	'补': '補',
+	'袭': '襲',
+	'见': '見',
+	'观': '観',
+	'规': '規',
+	'觅': '覓',
+	'视': '視',
+	'览': '覧',
+	'觉': '覚',
+	'计': '計',
+	'订': '訂',
+	'认': '認',
+	'讨': '討',
+	'让': '譲',
+	'议': '議',
+	'记': '記',
+	'讲': '講',
+	'许': '許',
+	'论': '論',
+	'设': '設',
+	'访': '訪',
+	'证': '証',
+	'评': '評',
+	'识': '識',
+	'诉': '訴',
+	'诊': '診',
+	'词': '詞',
+	'译': '訳',
+	'试': '試',
+	'诗': '詩',
+	'诚': '誠',
+	'话': '話',
+	'诞': '誕',
+	'询': '詢',
+	'该': '該',
+	'详': '詳',
+	'语': '語',
+	'误': '誤',
+	'诱': '誘',
+	'说': '説',
+	'请': '請',
+	'诸': '諸',
+	'诺': '諾',
+	'读': '読',
+	'课': '課',
+	'谁': '誰',
+	'调': '調',
+	'谅': '諒',
+	'谈': '談',
+	'谋': '謀',
+	'谓': '謂',
+	'谢': '謝',
+	'谦': '謙',
+	'谨': '謹',
+	'谱': '譜',
+	'贝': '貝',
+	'负': '負',
+	'贡': '貢',
+	'财': '財',
+	'责': '責',
+	'贤': '賢',
+	'败': '敗',
+	'货': '貨',
+	'质': '質',
+	'贪': '貪',
+	'贫': '貧',
+	'贯': '貫',
+	'贵': '貴',
+	'贷': '貸',
+	'贸': '貿',
+	'费': '費',
+	'贺': '賀',
+	'贿': '賄',
+	'资': '資',
+	'赌': '賭',
+	'赏': '賞',
+	'赔': '賠',
+	'赖': '頼',
+	'赞': '賛',
+	'赵': '趙',
+	'趋': '趨',
+	'跃': '躍',
+	'车': '車',
+	'轨': '軌',
+	'转': '転',
+	'轮': '輪',
+	'软': '軟',
+	'轻': '軽',
+	'载': '載',
+	'较': '較',
+	'辉': '輝',
+	'输': '輸',
+	'边': '辺',
+	'达': '達',
+	'过': '過',
+	'运': '運',
+	'还': '還',
+	'这': '這',
+	'进': '進',
+	'远': '遠',
+	'违': '違',
+	'连': '連',
+	'迟': '遅',
+	'选': '選',
+	'递': '逓',
+	'逻': '邏',
+	'遗': '遺',
+	'邮': '郵',
+	'邻': '隣',
+	'郑': '鄭',
+	'酱': '醤',
+	'释': '釈',
+	'鉴': '鑒',
+	'针': '針',
+	'钓': '釣',
+	'钟': '鐘',
+	'钢': '鋼',
+	'钱': '銭',
+	'铁': '鉄',
+	'铃': '鈴',
+	'铜': '銅',
+	'银': '銀',
+	'锁': '鎖',
+	'锅': '鍋',
+	'锐': '鋭',
+	'错': '錯',
+	'锦': '錦',
+	'键': '鍵',
+	'锻': '鍛',
+	'镇': '鎮',
+	'镜': '鏡',
+	'长': '長',
+	'门': '門',
+	'闭': '閉',
+	'问': '問',
+	'闯': '闖',
+	'闲': '閑',
+	'间': '間',
+	'闸': '閘',
+	'闹': '鬧',
+	'闻': '聞',
+	'阁': '閣',
+	'阅': '閲',
+	'阵': '陣',
+	'阶': '階',
+	'际': '際',
+	'陆': '陸',
+	'陈': '陳',
+	'险': '険',
+	'隐': '隠',
+	'难': '難',
+	'雾': '霧',
+	'韩': '韓',
+	'页': '頁',
+	'顶': '頂',
+	'项': '項',
+	'顺': '順',
+	'须': '須',
+	'顾': '顧',
+	'颁': '頒',
+	'预': '預',
+	'领': '領',
+	'频': '頻',
+	'题': '題',
+	'颜': '顔',
+	'风': '風',
+	'飞': '飛',
+	'饭': '飯',
+	'饮': '飲',
+	'饰': '飾',
+	'饱': '飽',
+	'饲': '飼',
+	'饿': '餓',
+	'馆': '館',
+	'馈': '饋',
+	'马': '馬',
+	'驱': '駆',
+	'驳': '駁',
+	'驻': '駐',
+	'验': '験',
+	'骑': '騎',
+	'骚': '騒',
+	'鱼': '魚',
+	'鸟': '鳥',
+	'鸡': '鶏',
+	'鸣': '鳴',
+	'齐': '斉',
+	'齿': '歯',
+	'龙': '竜',
+	'龟': '亀',
+}
@@ -0,0 +1,140 @@
+package kanji
+
+// ----------------------------------------------------------------------------
+//  Type: SpecialReading
+// ----------------------------------------------------------------------------
+
+// SpecialReading is an entry of the table of the words with special readings
+// (付表) of the Joyo Kanji table. Such as jukujikun (熟字訓) and ateji (当て字)
+// which can not be read by the readings of each kanji. E.g. "明日" (あす).
+type SpecialReading struct {
+	// Reading is the reading of the words in hiragana.
+	Reading string `json:"reading"`
+	// Words is the list of the notations of the word. E.g. "叔父" and "伯父" for
+	// "おじ".
+	Words []string `json:"words"`
+}
+
+// SpecialReadings is the table of the 116 words with special readings (付表) of
+// the Joyo Kanji table of 2010, in the order of the table.
+//
+// Note that "師走" is also read as "しはす" and the words may have ordinary
+// readings as well. Such as "一日" (いちにち) and "上手" (かみて).
+var SpecialReadings = []SpecialReading{
+	{Reading: "あす", Words: []string{"明日"}},
+	{Reading: "あずき", Words: []string{"小豆"}},
+	{Reading: "あま", Words: []string{"海女", "海士"}},
+	{Reading: "いおう", Words: []string{"硫黄"}},
+	{Reading: "いくじ", Words: []string{"意気地"}},
+	{Reading: "いなか", Words: []string{"田舎"}},
+	{Reading: "いぶき", Words: []string{"息吹"}},
+	{Reading: "うなばら", Words: []string{"海原"}},
+	{Reading: "うば", Words: []string{"乳母"}},
+	{Reading: "うわき", Words: []string{"浮気"}},
+	{Reading: "うわつく", Words: []string{"浮つく"}},
+	{Reading: "えがお", Words: []string{"笑顔"}},
+	{Reading: "おじ", Words: []string{"叔父", "伯父"}},
+	{Reading: "おとな", Words: []string{"大人"}},
+	{Reading: "おとめ", Words: []string{"乙女"}},
+	{Reading: "おば", Words: []string{"叔母", "伯母"}},
+	{Reading: "おまわりさん", Words: []string{"お巡りさん"}},
+	{Reading: "おみき", Words: []string{"お神酒"}},
+	{Reading: "おもや", Words: []string{"母屋", "母家"}},
+	{Reading: "かあさん", Words: []string{"母さん"}},
+	{Reading: "かぐら", Words: []string{"神楽"}},
+	{Reading: "かし", Words: []string{"河岸"}},
+	{Reading: "かじ", Words: []string{"鍛冶"}},
+	{Reading: "かぜ", Words: []string{"風邪"}},
+	{Reading: "かたず", Words: []string{"固唾"}},
+	{Reading: "かな", Words: []string{"仮名"}},
+	{Reading: "かや", Words: []string{"蚊帳"}},
+	{Reading: "かわせ", Words: []string{"為替"}},
+	{Reading: "かわら", Words: []string{"河原", "川原"}},
+	{Reading: "きのう", Words: []string{"昨日"}},
+	{Reading: "きょう", Words: []string{"今日"}},
+	{Reading: "くだもの", Words: []string{"果物"}},
+	{Reading: "くろうと", Words: []string{"玄人"}},
+	{Reading: "けさ", Words: []string{"今朝"}},
+	{Reading: "けしき", Words: []string{"景色"}},
+	{Reading: "ここち", Words: []string{"心地"}},
+	{Reading: "こじ", Words: []string{"居士"}},
+	{Reading: "ことし", Words: []string{"今年"}},
+	{Reading: "さおとめ", Words: []string{"早乙女"}},
+	{Reading: "ざこ", Words: []string{"雑魚"}},
+	{Reading: "さじき", Words: []string{"桟敷"}},
+	{Reading: "さしつかえる", Words: []string{"差し支える"}},
+	{Reading: "さつき", Words: []string{"五月"}},
+	{Reading: "さなえ", Words: []string{"早苗"}},
+	{Reading: "さみだれ", Words: []string{"五月雨"}},
+	{Reading: "しぐれ", Words: []string{"時雨"}},
+	{Reading: "しっぽ", Words: []string{"尻尾"}},
+	{Reading: "しない", Words: []string{"竹刀"}},
+	{Reading: "しにせ", Words: []string{"老舗"}},
+	{Reading: "しばふ", Words: []string{"芝生"}},
+	{Reading: "しみず", Words: []string{"清水"}},
+	{Reading: "しゃみせん", Words: []string{"三味線"}},
+	{Reading: "じゃり", Words: []string{"砂利"}},
+	{Reading: "じゅず", Words: []string{"数珠"}},
+	{Reading: "じょうず", Words: []string{"上手"}},
+	{Reading: "しらが", Words: []string{"白髪"}},
+	{Reading: "しろうと", Words: []string{"素人"}},
+	{Reading: "しわす", Words: []string{"師走"}},
+	{Reading: "すきや", Words: []string{"数寄屋", "数奇屋"}},
+	{Reading: "すもう", Words: []string{"相撲"}},
+	{Reading: "ぞうり", Words: []string{"草履"}},
+	{Reading: "だし", Words: []string{"山車"}},
+	{Reading: "たち", Words: []string{"太刀"}},
+	{Reading: "たちのく", Words: []string{"立ち退く"}},
+	{Reading: "たなばた", Words: []string{"七夕"}},
+	{Reading: "たび", Words: []string{"足袋"}},
+	{Reading: "ちご", Words: []string{"稚児"}},
+	{Reading: "ついたち", Words: []string{"一日"}},
+	{Reading: "つきやま", Words: []string{"築山"}},
+	{Reading: "つゆ", Words: []string{"梅雨"}},
+	{Reading: "でこぼこ", Words: []string{"凸凹"}},
+	{Reading: "てつだう", Words: []string{"手伝う"}},
+	{Reading: "てんません", Words: []string{"伝馬船"}},
+	{Reading: "とあみ", Words: []string{"投網"}},
+	{Reading: "とうさん", Words: []string{"父さん"}},
+	{Reading: "とえはたえ", Words: []string{"十重二十重"}},
+	{Reading: "どきょう", Words: []string{"読経"}},
+	{Reading: "とけい", Words: []string{"時計"}},
+	{Reading: "ともだち", Words: []string{"友達"}},
+	{Reading: "なこうど", Words: []string{"仲人"}},
+	{Reading: "なごり", Words: []string{"名残"}},
+	{Reading: "なだれ", Words: []string{"雪崩"}},
+	{Reading: "にいさん", Words: []string{"兄さん"}},
+	{Reading: "ねえさん", Words: []string{"姉さん"}},
+	{Reading: "のら", Words: []string{"野良"}},
+	{Reading: "のりと", Words: []string{"祝詞"}},
+	{Reading: "はかせ", Words: []string{"博士"}},
+	{Reading: "はたち", Words: []string{"二十", "二十歳"}},
+	{Reading: "はつか", Words: []string{"二十日"}},
+	{Reading: "はとば", Words: []string{"波止場"}},
+	{Reading: "ひとり", Words: []string{"一人"}},
+	{Reading: "ひより", Words: []string{"日和"}},
+	{Reading: "ふたり", Words: []string{"二人"}},
+	{Reading: "ふつか", Words: []string{"二日"}},
+	{Reading: "ふぶき", Words: []string{"吹雪"}},
+	{Reading: "へた", Words: []string{"下手"}},
+	{Reading: "へや", Words: []string{"部屋"}},
+	{Reading: "まいご", Words: []string{"迷子"}},
+	{Reading: "まじめ", Words: []string{"真面目"}},
+	{Reading: "まっか", Words: []string{"真っ赤"}},
+	{Reading: "まっさお", Words: []string{"真っ青"}},
+	{Reading: "みやげ", Words: []string{"土産"}},
+	{Reading: "むすこ", Words: []string{"息子"}},
+	{Reading: "めがね", Words: []string{"眼鏡"}},
+	{Reading: "もさ", Words: []string{"猛者"}},
+	{Reading: "もみじ", Words: []string{"紅葉"}},
+	{Reading: "もめん", Words: []string{"木綿"}},
+	{Reading: "もより", Words: []string{"最寄り"}},
+	{Reading: "やおちょう", Words: []string{"八百長"}},
+	{Reading: "やおや", Words: []string{"八百屋"}},
+	{Reading: "やまと", Words: []string{"大和"}},
+	{Reading: "やよい", Words: []string{"弥生"}},
+	{Reading: "ゆかた", Words: []string{"浴衣"}},
+	{Reading: "ゆくえ", Words: []string{"行方"}},
+	{Reading: "よせ", Words: []string{"寄席"}},
+	{Reading: "わこうど", Words: []string{"若人"}},
+}
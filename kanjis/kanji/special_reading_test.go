@@ -0,0 +1,32 @@
+package kanji
+
+import (
+	"testing"
+
+	"github.com/KEINOS/go-joyokanjis/kanjis/kana"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// This test ensures the number of the entries and that the words are unique.
+func TestSpecialReadings(t *testing.T) {
+	require.Len(t, SpecialReadings, 116)
+
+	words := make(map[string]struct{})
+
+	for _, entry := range SpecialReadings {
+		require.NotEmpty(t, entry.Words, "reading: %s", entry.Reading)
+
+		for _, char := range entry.Reading {
+			assert.True(t, kana.IsHiragana(char), "reading should be in hiragana: %s", entry.Reading)
+		}
+
+		for _, word := range entry.Words {
+			_, found := words[word]
+
+			require.False(t, found, "duplicate word: %s", word)
+
+			words[word] = struct{}{}
+		}
+	}
+}
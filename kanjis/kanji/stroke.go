@@ -0,0 +1,190 @@
+package kanji
+
+import (
+	"bytes"
+	"encoding/xml"
+	"io"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+)
+
+// ----------------------------------------------------------------------------
+//  Type: Stroke
+// ----------------------------------------------------------------------------
+
+// Stroke is a stroke of the kanji in the order of writing. The path is in the
+// coordinate of 109x109 as KanjiVG.
+type Stroke struct {
+	// Path is the SVG path data of the stroke. E.g. "M12.5,54.5c20.3,-1.6 ...".
+	Path string `json:"path"`
+	// Type is the CJK stroke type of the stroke. E.g. "㇐" (horizontal) and
+	// "㇑" (vertical). Empty if unknown.
+	Type string `json:"type,omitempty"`
+}
+
+// Start returns the start point of the stroke. Which is the point of the first
+// "moveto" command of the path. It returns false if the path does not start
+// with the command.
+func (s Stroke) Start() (x, y float64, ok bool) {
+	path := strings.TrimSpace(s.Path)
+	if path == "" || (path[0] != 'M' && path[0] != 'm') {
+		return 0, 0, false
+	}
+
+	x, rest, ok := parsePathNumber(path[1:])
+	if !ok {
+		return 0, 0, false
+	}
+
+	y, _, ok = parsePathNumber(rest)
+	if !ok {
+		return 0, 0, false
+	}
+
+	return x, y, true
+}
+
+// ----------------------------------------------------------------------------
+//  Type: StrokeDict
+// ----------------------------------------------------------------------------
+
+// StrokeDict is a map of the strokes of the kanji in the order of writing. The
+// key is the rune (int32) that represents the kanji.
+type StrokeDict map[rune][]Stroke
+
+// ----------------------------------------------------------------------------
+//  Constructor
+// ----------------------------------------------------------------------------
+
+// NewStrokeDict parses the XML data in the format of KanjiVG (kanjivg.xml) to
+// StrokeDict object. The variants of the kanji, such as the Kaisho (楷書) forms,
+// are skipped.
+func NewStrokeDict(data []byte) (*StrokeDict, error) {
+	tmpDict := make(StrokeDict)
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+
+	var (
+		char    rune
+		strokes []Stroke
+	)
+
+	for {
+		token, err := decoder.Token()
+		if errors.Is(err, io.EOF) {
+			return &tmpDict, nil
+		}
+
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to parse the KanjiVG data")
+		}
+
+		switch element := token.(type) {
+		case xml.StartElement:
+			switch element.Name.Local {
+			case "kanji":
+				if char, err = parseKanjiVGID(xmlAttr(element, "id")); err != nil {
+					return nil, err
+				}
+
+				strokes = nil
+			case "path":
+				if char != 0 {
+					strokes = append(strokes, Stroke{
+						Path: xmlAttr(element, "d"),
+						Type: xmlAttr(element, "type"),
+					})
+				}
+			}
+		case xml.EndElement:
+			if element.Name.Local == "kanji" && char != 0 {
+				tmpDict[char] = strokes
+				char = 0
+			}
+		}
+	}
+}
+
+// ----------------------------------------------------------------------------
+//  Methods
+// ----------------------------------------------------------------------------
+
+// Delete removes the given kanji from the dictionary.
+func (d StrokeDict) Delete(kanji ...rune) {
+	for _, char := range kanji {
+		delete(d, char)
+	}
+}
+
+// Find returns the strokes of the given kanji in the order of writing. It
+// returns false if the kanji is not in the dictionary.
+func (d StrokeDict) Find(kanji rune) ([]Stroke, bool) {
+	strokes, ok := d[kanji]
+
+	return strokes, ok
+}
+
+// Len returns the number of kanji registered in the dictionary.
+func (d StrokeDict) Len() int {
+	return len(d)
+}
+
+// ----------------------------------------------------------------------------
+//  Private functions
+// ----------------------------------------------------------------------------
+
+// parseKanjiVGID returns the kanji of the given id of the <kanji> element of
+// KanjiVG. E.g. '一' for "kvg:kanji_04e00". It returns 0 for the variants such
+// as "kvg:kanji_04e00-Kaisho".
+func parseKanjiVGID(id string) (rune, error) {
+	hex := strings.TrimPrefix(id, "kvg:kanji_")
+	if strings.Contains(hex, "-") {
+		return 0, nil
+	}
+
+	codePoint, err := strconv.ParseUint(hex, 16, 32)
+	if err != nil || codePoint > utf8.MaxRune {
+		return 0, errors.Errorf("invalid kanji id in KanjiVG: %q", id)
+	}
+
+	return rune(codePoint), nil
+}
+
+// parsePathNumber parses the number at the beginning of the given SVG path data
+// and returns the rest. The leading white spaces and commas are skipped.
+func parsePathNumber(path string) (float64, string, bool) {
+	path = strings.TrimLeft(path, " \t\r\n,")
+
+	end := 0
+	for end < len(path) {
+		c := path[end]
+		isSign := (c == '-' || c == '+') && end == 0
+
+		if !isSign && c != '.' && (c < '0' || c > '9') {
+			break
+		}
+
+		end++
+	}
+
+	number, err := strconv.ParseFloat(path[:end], 64)
+	if err != nil {
+		return 0, path, false
+	}
+
+	return number, path[end:], true
+}
+
+// xmlAttr returns the value of the attribute of the given local name in the
+// element regardless of the namespace. Such as "kvg:type". Empty if not found.
+func xmlAttr(element xml.StartElement, name string) string {
+	for _, attr := range element.Attr {
+		if attr.Name.Local == name {
+			return attr.Value
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,84 @@
+package kanji
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewStrokeDict(t *testing.T) {
+	dict := loadSampleStrokes(t)
+
+	require.Equal(t, 4, dict.Len(), "variants should be skipped")
+
+	strokes, ok := dict.Find('二')
+	require.True(t, ok)
+	require.Len(t, strokes, 2, "variants should not be merged")
+	assert.Equal(t, Stroke{Type: "㇐", Path: "M27.5,30.5c14.6,-0.9 41.4,-2.4 54.5,-2"}, strokes[0])
+
+	strokes, ok = dict.Find('川')
+	require.True(t, ok)
+	require.Len(t, strokes, 3, "strokes in the nested groups should be included")
+	assert.Equal(t, "㇒", strokes[0].Type)
+
+	_, ok = dict.Find('三')
+	assert.False(t, ok)
+
+	dict.Delete('丂')
+
+	assert.Equal(t, 3, dict.Len())
+}
+
+func TestNewStrokeDict_invalid(t *testing.T) {
+	for _, test := range []struct {
+		input     string
+		expectErr string
+	}{
+		{"<kanjivg></kanji>", "failed to parse the KanjiVG data"},
+		{`<kanjivg><kanji id="kvg:kanji_zzz"></kanji></kanjivg>`, `invalid kanji id in KanjiVG: "kvg:kanji_zzz"`},
+		{`<kanjivg><kanji id="kvg:kanji_ffffffff"></kanji></kanjivg>`, "invalid kanji id in KanjiVG"},
+	} {
+		dict, err := NewStrokeDict([]byte(test.input))
+
+		require.Error(t, err, "input: %q", test.input)
+		assert.Contains(t, err.Error(), test.expectErr)
+		assert.Nil(t, dict)
+	}
+}
+
+func TestStroke_Start(t *testing.T) {
+	for _, test := range []struct {
+		path  string
+		x, y  float64
+		valid bool
+	}{
+		{"M12.5,54.5c20.3,-1.6 64.9,-3.2 85.5,-2.5", 12.5, 54.5, true},
+		{" M 40 30 l0,40", 40, 30, true},
+		{"m-1.5-2c0,0", -1.5, -2, true},
+		{"c20.3,-1.6", 0, 0, false},
+		{"M12.5", 0, 0, false},
+		{"M,", 0, 0, false},
+		{"", 0, 0, false},
+	} {
+		x, y, ok := Stroke{Path: test.path}.Start()
+
+		assert.Equal(t, test.valid, ok, "path: %q", test.path)
+		assert.Equal(t, test.x, x, "path: %q", test.path)
+		assert.Equal(t, test.y, y, "path: %q", test.path)
+	}
+}
+
+func loadSampleStrokes(t *testing.T) *StrokeDict {
+	t.Helper()
+
+	data, err := os.ReadFile(filepath.Join("testdata", "strokes_sample.xml"))
+	require.NoError(t, err)
+
+	dict, err := NewStrokeDict(data)
+	require.NoError(t, err)
+
+	return dict
+}
@@ -0,0 +1,188 @@
+package kanji
+
+import (
+	"fmt"
+	"html"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Parameters of the SVG rendering. The size is the coordinate of KanjiVG.
+const (
+	svgSize          = 109
+	svgFrameDuration = 0.5 // seconds per stroke of the animation
+	svgDigitWidth    = 3.0
+	svgDigitHeight   = 5.0
+	svgColorStroke   = "#000000"
+	svgColorCurrent  = "#cc0000"
+	svgColorNumber   = "#808080"
+)
+
+// svgDigitSegments is the seven segments of the digits drawn for the stroke
+// numbers. The segments are "a" (top), "b" (upper right), "c" (lower right),
+// "d" (bottom), "e" (lower left), "f" (upper left) and "g" (middle).
+var svgDigitSegments = [10]string{
+	"abcdef", "bc", "abdeg", "abcdg", "bcfg", "acdfg", "acdefg", "abc", "abcdefg", "abcdfg",
+}
+
+// ----------------------------------------------------------------------------
+//  Type: SVGOption
+// ----------------------------------------------------------------------------
+
+// SVGOption is the bit flags of the options for RenderSVG and RenderSVGFrames.
+type SVGOption uint
+
+const (
+	// SVGNumbered draws the stroke numbers near the start point of the strokes.
+	// The numbers are drawn as paths, so no font is required.
+	SVGNumbered SVGOption = 1 << iota
+	// SVGAnimated draws the strokes one by one in the order of writing by SMIL
+	// animation. Which is ignored by RenderSVGFrames.
+	SVGAnimated
+)
+
+// ----------------------------------------------------------------------------
+//  Public functions
+// ----------------------------------------------------------------------------
+
+// RenderSVG returns the SVG image of the given strokes in the size of 109x109
+// as KanjiVG. See SVGOption for the options. The options can be combined. E.g.
+// SVGNumbered|SVGAnimated.
+//
+// It returns an empty string if no stroke is given.
+func RenderSVG(strokes []Stroke, opts SVGOption) string {
+	if len(strokes) == 0 {
+		return ""
+	}
+
+	return renderSVG(strokes, len(strokes), opts, false)
+}
+
+// RenderSVGFrames returns the SVG images of each step of writing the given
+// strokes. The n-th frame has the first n strokes and the last stroke of the
+// frame is highlighted. It is useful to create a stroke order chart or an
+// animation of the frames.
+//
+// The SVGAnimated option is ignored. It returns nil if no stroke is given.
+func RenderSVGFrames(strokes []Stroke, opts SVGOption) []string {
+	if len(strokes) == 0 {
+		return nil
+	}
+
+	frames := make([]string, len(strokes))
+
+	for i := range strokes {
+		frames[i] = renderSVG(strokes, i+1, opts&^SVGAnimated, true)
+	}
+
+	return frames
+}
+
+// ----------------------------------------------------------------------------
+//  Private functions
+// ----------------------------------------------------------------------------
+
+// renderSVG renders the first n strokes of the given strokes. If highlight is
+// true, the n-th stroke is drawn in a different color.
+func renderSVG(strokes []Stroke, n int, opts SVGOption, highlight bool) string {
+	var svg strings.Builder
+
+	fmt.Fprintf(&svg,
+		"<svg xmlns=\"http://www.w3.org/2000/svg\" width=\"%d\" height=\"%d\" viewBox=\"0 0 %d %d\">\n",
+		svgSize, svgSize, svgSize, svgSize)
+	fmt.Fprintf(&svg,
+		"<g fill=\"none\" stroke=\"%s\" stroke-width=\"3\" stroke-linecap=\"round\" stroke-linejoin=\"round\">\n",
+		svgColorStroke)
+
+	for i, stroke := range strokes[:n] {
+		attrs := ""
+		if highlight && i == n-1 {
+			attrs = fmt.Sprintf(" stroke=\"%s\"", svgColorCurrent)
+		}
+
+		if opts&SVGAnimated != 0 {
+			attrs += " visibility=\"hidden\""
+		}
+
+		fmt.Fprintf(&svg, "<g%s>\n", attrs)
+		// The path is from the input data. Escape it to keep the SVG well-formed.
+		fmt.Fprintf(&svg, "<path d=\"%s\"/>\n", html.EscapeString(stroke.Path))
+
+		if opts&SVGNumbered != 0 {
+			if number := numberPath(stroke, i+1); number != "" {
+				fmt.Fprintf(&svg, "<path d=\"%s\" stroke=\"%s\" stroke-width=\"0.8\"/>\n", number, svgColorNumber)
+			}
+		}
+
+		if opts&SVGAnimated != 0 {
+			fmt.Fprintf(&svg, "<set attributeName=\"visibility\" to=\"visible\" begin=\"%ss\" fill=\"freeze\"/>\n",
+				formatCoord(float64(i)*svgFrameDuration))
+		}
+
+		svg.WriteString("</g>\n")
+	}
+
+	svg.WriteString("</g>\n</svg>\n")
+
+	return svg.String()
+}
+
+// numberPath returns the SVG path data of the given stroke number placed at the
+// upper left of the start point of the stroke. Empty if the start point is
+// unknown.
+func numberPath(stroke Stroke, number int) string {
+	x, y, ok := stroke.Start()
+	if !ok {
+		return ""
+	}
+
+	digits := strconv.Itoa(number)
+	width := float64(len(digits))*(svgDigitWidth+1) - 1
+
+	// Keep the number inside the image.
+	left := math.Max(1, math.Min(x-width-2, svgSize-width-1))
+	top := math.Max(1, math.Min(y-svgDigitHeight-2, svgSize-svgDigitHeight-1))
+
+	paths := make([]string, 0, len(digits))
+
+	for i, digit := range digits {
+		paths = append(paths, digitPath(left+float64(i)*(svgDigitWidth+1), top, int(digit-'0')))
+	}
+
+	return strings.Join(paths, "")
+}
+
+// digitPath returns the SVG path data of the seven segments of the given digit
+// at the given upper left point.
+func digitPath(left, top float64, digit int) string {
+	const half = svgDigitHeight / 2
+
+	lines := map[rune][4]float64{
+		'a': {0, 0, svgDigitWidth, 0},
+		'b': {svgDigitWidth, 0, svgDigitWidth, half},
+		'c': {svgDigitWidth, half, svgDigitWidth, svgDigitHeight},
+		'd': {0, svgDigitHeight, svgDigitWidth, svgDigitHeight},
+		'e': {0, half, 0, svgDigitHeight},
+		'f': {0, 0, 0, half},
+		'g': {0, half, svgDigitWidth, half},
+	}
+
+	var path strings.Builder
+
+	for _, segment := range svgDigitSegments[digit] {
+		line := lines[segment]
+
+		fmt.Fprintf(&path, "M%s,%sL%s,%s",
+			formatCoord(left+line[0]), formatCoord(top+line[1]),
+			formatCoord(left+line[2]), formatCoord(top+line[3]))
+	}
+
+	return path.String()
+}
+
+// formatCoord returns the given coordinate rounded to one decimal place without
+// the trailing zeros. E.g. "12.5" and "3".
+func formatCoord(value float64) string {
+	return strconv.FormatFloat(math.Round(value*10)/10, 'f', -1, 64)
+}
@@ -0,0 +1,99 @@
+package kanji
+
+import (
+	"encoding/xml"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenderSVG(t *testing.T) {
+	strokes := loadSampleStrokes(t)
+
+	svg := RenderSVG((*strokes)['二'], 0)
+
+	assert.Equal(t, `<svg xmlns="http://www.w3.org/2000/svg" width="109" height="109" viewBox="0 0 109 109">
+<g fill="none" stroke="#000000" stroke-width="3" stroke-linecap="round" stroke-linejoin="round">
+<g>
+<path d="M27.5,30.5c14.6,-0.9 41.4,-2.4 54.5,-2"/>
+</g>
+<g>
+<path d="M14.5,77c20.8,-1.2 63.5,-2.9 81,-2"/>
+</g>
+</g>
+</svg>
+`, svg)
+
+	assert.Empty(t, RenderSVG(nil, SVGNumbered))
+}
+
+func TestRenderSVG_escape_path(t *testing.T) {
+	svg := RenderSVG([]Stroke{{Path: `M1,1"/><script>alert(1)</script><path d="`}}, 0)
+
+	requireWellFormed(t, svg)
+	assert.NotContains(t, svg, "<script>", "the path should not inject elements")
+	assert.Contains(t, svg, `<path d="M1,1&#34;/&gt;&lt;script&gt;alert(1)&lt;/script&gt;&lt;path d=&#34;"/>`)
+}
+
+func TestRenderSVG_options(t *testing.T) {
+	strokes := loadSampleStrokes(t)
+
+	svg := RenderSVG((*strokes)['川'], SVGNumbered|SVGAnimated)
+
+	requireWellFormed(t, svg)
+	assert.Equal(t, 6, strings.Count(svg, "<path "), "each stroke should have a number")
+	assert.Contains(t, svg, `<g visibility="hidden">`)
+	assert.Contains(t, svg, `begin="0s"`)
+	assert.Contains(t, svg, `begin="1s"`, "the third stroke should appear after 1 second")
+	assert.NotContains(t, svg, "<text", "no font should be required")
+
+	// Stroke without the start point is not numbered
+	svg = RenderSVG([]Stroke{{Path: "c1,1"}}, SVGNumbered)
+
+	assert.Equal(t, 1, strings.Count(svg, "<path "))
+}
+
+func TestRenderSVGFrames(t *testing.T) {
+	strokes := loadSampleStrokes(t)
+
+	frames := RenderSVGFrames((*strokes)['川'], SVGNumbered|SVGAnimated)
+
+	require.Len(t, frames, 3)
+
+	for i, frame := range frames {
+		requireWellFormed(t, frame)
+		assert.Equal(t, (i+1)*2, strings.Count(frame, "<path "), "frame %d should have the first strokes", i+1)
+		assert.Equal(t, 1, strings.Count(frame, `stroke="#cc0000"`), "the last stroke should be highlighted")
+		assert.NotContains(t, frame, "<set ", "animation should be ignored")
+	}
+
+	assert.Nil(t, RenderSVGFrames(nil, 0))
+}
+
+func Test_numberPath(t *testing.T) {
+	// Digit 1 is the segments "b" and "c" at the upper left of the start point
+	assert.Equal(t, "M11,18L11,20.5M11,20.5L11,23", numberPath(Stroke{Path: "M13,25"}, 1))
+
+	// Numbers are kept inside the image
+	path := numberPath(Stroke{Path: "M0,0"}, 12)
+
+	assert.True(t, strings.HasPrefix(path, "M4,1L4,3.5"), "got: %s", path)
+	assert.Empty(t, numberPath(Stroke{}, 1))
+}
+
+func requireWellFormed(t *testing.T, svg string) {
+	t.Helper()
+
+	decoder := xml.NewDecoder(strings.NewReader(svg))
+
+	for {
+		_, err := decoder.Token()
+		if err != nil {
+			require.Equal(t, "EOF", err.Error(), "the SVG should be well-formed XML")
+
+			return
+		}
+	}
+}
@@ -14,6 +14,28 @@ type Yomi struct {
 	// KunYomi is the list of "Kun" readings (kun-yomi, "訓読み") of the Kanji.
 	// Which is the original, indigenous Japanese readings.
 	KunYomi []kana.Kanas `json:"kun_yomi,omitempty"`
-	// ExampleYomi is the list of example readings of the Kanji.
+	// ExampleYomi is the list of example readings of the Kanji. Which are the
+	// kun-yomi with okurigana. E.g. "たの-しい". Use ExampleReadings to get them
+	// with the okurigana separated.
 	ExampleYomi []string `json:"example_yomi,omitempty"`
 }
+
+// ----------------------------------------------------------------------------
+//  Methods
+// ----------------------------------------------------------------------------
+
+// ExampleReadings returns the example readings (ExampleYomi) parsed as Reading.
+// E.g. "たの-しい" to the stem "たの" and the okurigana "しい".
+func (y Yomi) ExampleReadings() []Reading {
+	if len(y.ExampleYomi) == 0 {
+		return nil
+	}
+
+	readings := make([]Reading, 0, len(y.ExampleYomi))
+
+	for _, example := range y.ExampleYomi {
+		readings = append(readings, ParseReading(example))
+	}
+
+	return readings
+}
@@ -9,6 +9,32 @@ It provides functions to:
 
 3. Search for the readings (読み, yomi) of the given kanji.
 
+4. Canonicalize the characters mapped differently between JIS X 0208 and CP932
+(such as the wave dash, '〜' and '～').
+
+5. Convert hentaigana (変体仮名) and archaic kana to modern kana.
+
+6. Convert new kanji (shinjitai) back to old kanji (kyujitai) for reprints.
+
+7. Compare strings such as names modulo old, variant and compatibility kanji.
+
+8. Detect if the given character is a jinmeiyo kanji (人名用漢字) and if the name
+is registrable in the family register (戸籍).
+
+9. Flag kanji above the level of the audience. Such as the kanji not taught by
+the given school grade.
+
+10. Detect characters not representable in legacy encodings such as Shift_JIS
+and CP932.
+
+11. Build sets of kanji (such as Joyo Kanji and the kanji of each grade) for
+validators, regular expressions and font subsets.
+
+12. Search for the Joyo Kanji by reading. Such as the kanji read "ガク".
+
+13. Find the words with special readings (付表) of the Joyo Kanji table. Such as
+"明日" (あす) and "大人" (おとな).
+
 */
 //go:generate go run internal/converter.go
 package kanjis
@@ -17,9 +43,11 @@ import (
 	"bytes"
 	_ "embed"
 	"io"
+	"sync"
 
 	"github.com/KEINOS/go-joyokanjis/kanjis/converter"
 	"github.com/KEINOS/go-joyokanjis/kanjis/internal/tool"
+	"github.com/KEINOS/go-joyokanjis/kanjis/kana"
 	"github.com/KEINOS/go-joyokanjis/kanjis/kanji"
 	"github.com/pkg/errors"
 )
@@ -30,10 +58,21 @@ var (
 	//
 	//go:embed internal/gzgob/dict.gzip
 	gzData []byte
+	// gzJinmeiyoData is the embedded GZipped Gob encoded Jinmeiyo Kanji list.
+	//
+	//go:embed internal/gzgob/jinmeiyo.gzip
+	gzJinmeiyoData []byte
 	// kanjiDict is the singleton object that holds the Joyo Kanji dictionary.
 	kanjiDict kanji.Dict
+	// jinmeiyoDict is the singleton object that holds the Jinmeiyo Kanji list.
+	jinmeiyoDict kanji.JinmeiyoDict
 	// ignoreList
 	ignoreList map[rune]interface{}
+	// readingIndex is the reverse index of the readings of kanjiDict. It is
+	// created on the first use of FindByReading.
+	readingIndex *kanji.ReadingIndex
+	// onceReadingIndex creates readingIndex only once.
+	onceReadingIndex sync.Once
 )
 
 // ----------------------------------------------------------------------------
@@ -51,12 +90,39 @@ func initialize() {
 	if err := extractEmbeddedData(); err != nil {
 		panic(errors.Wrap(err, "initilization failed in package kanjis"))
 	}
+
+	buildPredefinedSets()
 }
 
 // ----------------------------------------------------------------------------
 //  Public functions
 // ----------------------------------------------------------------------------
 
+// DiffEditions returns the kanji added and removed from the "from" edition to
+// the "to" edition of the official kanji list in ascending order of the code
+// point. E.g. from kanji.Joyo1981 to kanji.Joyo2010 returns the 196 added and
+// the 5 removed kanji ('勺', '錘', '銑', '脹' and '匁').
+func DiffEditions(from, to kanji.Edition) (added, removed []rune) {
+	return kanjiDict.DiffEditions(from, to)
+}
+
+// FindByReading returns the Joyo Kanji that have the given reading in ascending
+// order of the code point. E.g. "ガク" returns '学', '岳', '楽' and so on.
+//
+// Use the options to search the on-yomi, kun-yomi or example readings only,
+// to match by prefix and to ignore the difference between hiragana and
+// katakana. See kanji.ReadingOption for the details.
+//
+// The reverse index is created on the first call and is safe for concurrent
+// use.
+func FindByReading(reading string, opts kanji.ReadingOption) []rune {
+	onceReadingIndex.Do(func() {
+		readingIndex = kanji.NewReadingIndex(kanjiDict)
+	})
+
+	return readingIndex.Find(reading, opts)
+}
+
 // FixRuneAsJoyo returns the Joyo Kanji if the given character is a registered
 // Kyujitai (old kanji) and has a new kanji (shinjitai) in the dictionary.
 //
@@ -102,6 +168,48 @@ func FixFileAsJoyo(input io.Reader, output io.Writer) error {
 	return errors.Wrap(err, "failed to convert the input to the output")
 }
 
+// FixRuneAsModern is similar to FixRuneAsJoyo but it also converts hentaigana
+// (変体仮名) and archaic kana to modern kana. E.g. '𛀁' (U+1B001) to 'え'.
+func FixRuneAsModern(char rune) rune {
+	if ignoreList != nil {
+		if _, ok := ignoreList[char]; ok {
+			return char
+		}
+	}
+
+	if modern := kana.ToModernKana(char); modern != char {
+		return modern
+	}
+
+	return kanjiDict.FixAsJoyo(char)
+}
+
+// FixStringAsModern is similar to FixRuneAsModern but for string.
+func FixStringAsModern(input string) string {
+	inRune := []rune(input)
+
+	for i, char := range inRune {
+		inRune[i] = FixRuneAsModern(char)
+	}
+
+	return string(inRune)
+}
+
+// FixFileAsModern is similar to FixRuneAsModern but for file.
+func FixFileAsModern(input io.Reader, output io.Writer) error {
+	if input == nil || output == nil {
+		return errors.New("input or output is nil")
+	}
+
+	tf := converter.New(func(in rune) rune {
+		return FixRuneAsModern(in)
+	})
+
+	err := tf.Convert(input, output)
+
+	return errors.Wrap(err, "failed to convert the input to the output")
+}
+
 // Ignore adds the given characters to the ignore list. These characters will be
 // ignored when converting old kanji (kyujitai) to new kanji (shinjitai).
 func Ignore(char ...rune) {
@@ -114,11 +222,29 @@ func Ignore(char ...rune) {
 	}
 }
 
+// IsJinmeiyoKanji returns true if the given rune is a Jinmeiyo Kanji (人名用漢字)
+// character. Including the old forms of Joyo Kanji that can be used for personal
+// names. E.g. '亞'.
+//
+// Note that Joyo Kanji are not Jinmeiyo Kanji. Use IsRegistrableInName to check
+// if the characters can be used for personal names.
+func IsJinmeiyoKanji(char rune) bool {
+	return jinmeiyoDict.IsJinmeiyoKanji(char)
+}
+
 // IsJoyoKanji returns true if the given rune is a Joyo Kanji character.
 func IsJoyoKanji(char rune) bool {
 	return kanjiDict.IsJoyoKanji(char)
 }
 
+// IsJoyoKanjiIn returns true if the given rune is in the official kanji list of
+// the given edition. Such as kanji.Toyo1946, kanji.Joyo1981 and kanji.Joyo2010.
+//
+// IsJoyoKanjiIn(char, kanji.Joyo2010) is equivalent to IsJoyoKanji(char).
+func IsJoyoKanjiIn(char rune, edition kanji.Edition) bool {
+	return kanjiDict.IsJoyoKanjiIn(char, edition)
+}
+
 // IsKyuJitai returns true if the given rune is a registered Kyujitai (old kanji)
 // character which contains a new kanji (shinjitai) in the dictionary.
 func IsKyuJitai(char rune) bool {
@@ -130,6 +256,20 @@ func LenDict() int {
 	return kanjiDict.LenJoyo()
 }
 
+// LenJinmeiyo returns the number of Jinmeiyo Kanjis registered in the list.
+func LenJinmeiyo() int {
+	return jinmeiyoDict.Len()
+}
+
+// Readings returns the readings of the given Joyo Kanji. See
+// kanji.Dict.Readings for the order of the readings. The old forms (kyujitai)
+// return the readings of the new form.
+//
+// It returns nil if the kanji is not a Joyo Kanji.
+func Readings(char rune) []kanji.Reading {
+	return kanjiDict.Readings(char)
+}
+
 // ResetIgnore clears the ignore list.
 func ResetIgnore() {
 	ignoreList = nil
@@ -140,13 +280,19 @@ func ResetIgnore() {
 // ----------------------------------------------------------------------------
 
 // extractEmbeddedData extracts the embedded GZipped Gob encoded dictionary and
-// sets the decoded data to kanjiDict object as a singleton.
+// sets the decoded data to kanjiDict object as a singleton. So as the Jinmeiyo
+// Kanji list to jinmeiyoDict.
 func extractEmbeddedData() error {
 	// Read embedded gzipped data
 	src := bytes.NewReader(gzData)
 
 	// Extract and decode the embedded GZipped Gob encoded data and assign to
 	// kanjiDict
-	return errors.Wrap(tool.ExtractGzipGobToDict(src, &kanjiDict),
-		"failed to extract and decode the embedded GZipped Gob encoded data")
+	if err := tool.ExtractGzipGobToDict(src, &kanjiDict); err != nil {
+		return errors.Wrap(err,
+			"failed to extract and decode the embedded GZipped Gob encoded data")
+	}
+
+	return errors.Wrap(tool.ExtractGzipGobToDict(bytes.NewReader(gzJinmeiyoData), &jinmeiyoDict),
+		"failed to extract and decode the embedded Jinmeiyo Kanji list")
 }
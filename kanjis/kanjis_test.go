@@ -177,6 +177,78 @@ func TestFixStringAsJoyo_goroutine(t *testing.T) {
 	wg.Wait()
 }
 
+// ----------------------------------------------------------------------------
+//  FixFileAsModern()
+// ----------------------------------------------------------------------------
+
+func TestFixFileAsModern_golden(t *testing.T) {
+	t.Parallel()
+
+	input := strings.NewReader("𛂞𛃝𛃱の𛀁𛂺に學ぶ")
+
+	var output bytes.Buffer
+
+	err := FixFileAsModern(input, &output)
+
+	require.NoError(t, err)
+	require.Equal(t, "はやりのえほに学ぶ", output.String())
+}
+
+func TestFixFileAsModern_out_file_is_nil(t *testing.T) {
+	t.Parallel()
+
+	err := FixFileAsModern(strings.NewReader("𛀁"), nil)
+
+	require.Error(t, err,
+		"nil output file should return an error")
+	assert.Contains(t, err.Error(), "input or output is nil",
+		"it should contain the error reason")
+}
+
+func TestFixFileAsModern_fail_during_read(t *testing.T) {
+	t.Parallel()
+
+	input := &DummyReader{
+		Count:        0,
+		ErrorOnCount: 1,
+	}
+
+	var output bytes.Buffer
+
+	err := FixFileAsModern(input, &output)
+
+	require.Error(t, err,
+		"it should fail during the scan")
+	assert.Contains(t, err.Error(), "failed to convert the input to the output",
+		"it should contain the error reason")
+}
+
+// ----------------------------------------------------------------------------
+//  FixRuneAsModern()
+// ----------------------------------------------------------------------------
+
+func TestFixRuneAsModern(t *testing.T) {
+	for _, test := range []struct {
+		input  rune
+		expect rune
+	}{
+		{'𛀁', 'え'}, // HIRAGANA LETTER ARCHAIC YE
+		{'𛀂', 'あ'}, // HENTAIGANA LETTER A-1
+		{'舊', '旧'}, // Old kanji
+		{'𠮟', '𠮟'}, // Joyo kanji in CJK Extension B
+		{'a', 'a'},
+	} {
+		assert.Equal(t, test.expect, FixRuneAsModern(test.input),
+			"%q should be converted to %q", string(test.input), string(test.expect))
+	}
+
+	// Ignored characters are returned as is
+	Ignore('\U0001B001')
+	defer ResetIgnore()
+
+	assert.Equal(t, '\U0001B001', FixRuneAsModern('\U0001B001'))
+}
+
 // ----------------------------------------------------------------------------
 //  IsJoyoKanji()
 // ----------------------------------------------------------------------------
@@ -234,6 +306,22 @@ func Test_range_keys_dict(t *testing.T) {
 	require.True(t, kanji.IsCJK(actualHighestKey), "Highest key is not in range of kanji.IsCJK")
 }
 
+// This test ensures that the old kanjis in kanji.NonJoyoOld2NewMap are not
+// registered in the embedded dictionary. Old forms of Joyo Kanji must be added
+// via kanji.ExtraKyuJitaiMap.
+func Test_NonJoyoOld2NewMap_not_in_dict(t *testing.T) {
+	for oldKanji, newKanji := range kanji.NonJoyoOld2NewMap {
+		_, found := kanjiDict.Find(oldKanji)
+
+		assert.False(t, found,
+			"%s (%U) is registered in the dictionary. Remove it from kanji.NonJoyoOld2NewMap",
+			string(oldKanji), oldKanji)
+		assert.False(t, IsJoyoKanji(newKanji),
+			"%s (%U) is a Joyo Kanji. Move %s to kanji.ExtraKyuJitaiMap",
+			string(newKanji), newKanji, string(oldKanji))
+	}
+}
+
 // ============================================================================
 //  Helper functions/types
 // ============================================================================
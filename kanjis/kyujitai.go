@@ -0,0 +1,323 @@
+package kanjis
+
+import (
+	"bytes"
+	"io"
+	"strings"
+
+	"github.com/KEINOS/go-joyokanjis/kanjis/converter"
+	"github.com/KEINOS/go-joyokanjis/kanjis/kanji"
+	"github.com/pkg/errors"
+)
+
+// DefaultKyuJitaiWords is the default word-level table for NewWordPolicy to
+// resolve the ambiguous Joyo Kanji. The key is the word in shinjitai and the
+// value is the word in kyujitai. Both must have the same number of characters.
+//
+// To add a new word, edit this map.
+var DefaultKyuJitaiWords = map[string]string{
+	// 弁: 辨 (distinguish), 辯 (speech), 瓣 (petal, valve)
+	"弁論": "辯論",
+	"弁護": "辯護",
+	"弁解": "辯解",
+	"弁明": "辯明",
+	"答弁": "答辯",
+	"雄弁": "雄辯",
+	"花弁": "花瓣",
+	"弁別": "辨別",
+	"弁償": "辨償",
+	"弁当": "辨當",
+	// 台: 臺 (platform), 台 (as is)
+	"舞台": "舞臺",
+	"土台": "土臺",
+	"台帳": "臺帳",
+	"灯台": "燈臺",
+	"天台": "天台",
+	// 芸: 藝 (art), 芸 (fragrant herb)
+	"芸術": "藝術",
+	"芸能": "藝能",
+	"文芸": "文藝",
+	"園芸": "園藝",
+	"芸香": "芸香",
+	// 余: 餘 (surplus), 余 (I, me)
+	"余地": "餘地",
+	"余裕": "餘裕",
+	"残余": "殘餘",
+	"余輩": "余輩",
+	// 予: 豫 (beforehand)
+	"予定": "豫定",
+	"予想": "豫想",
+	"予防": "豫防",
+	// 欠: 缺 (lack), 欠 (yawn)
+	"欠点": "缺點",
+	"欠席": "缺席",
+	"欠乏": "缺乏",
+	"欠伸": "欠伸",
+	// 灯: 燈 (light)
+	"電灯": "電燈",
+	"灯火": "燈火",
+	// 医: 醫 (medicine)
+	"医者": "醫者",
+	"医学": "醫學",
+}
+
+// ----------------------------------------------------------------------------
+//  Type: Ambiguity
+// ----------------------------------------------------------------------------
+
+// Ambiguity is a record of a Joyo Kanji that has several candidates of the old
+// form (kyujitai) found during the conversion by ToKyuJitai.
+type Ambiguity struct {
+	// Pos is the position of the character in the input.
+	Pos converter.Position `json:"pos"`
+	// Candidates is the list of the old forms of the character. It may contain
+	// the character itself if it was also an old kanji. E.g. '芸'.
+	Candidates []rune `json:"candidates"`
+	// Char is the ambiguous Joyo Kanji.
+	Char rune `json:"char"`
+	// Chosen is the character chosen by the policy.
+	Chosen rune `json:"chosen"`
+}
+
+// ----------------------------------------------------------------------------
+//  Type: Policy
+// ----------------------------------------------------------------------------
+
+// Policy resolves an ambiguous Joyo Kanji to one of its old forms (kyujitai).
+type Policy interface {
+	// Resolve returns the character to replace line[index] with. The line is
+	// the line of the input in shinjitai that contains the character and the
+	// candidates are the old forms of the character.
+	Resolve(line []rune, index int, candidates []rune) rune
+}
+
+// PolicyFunc is an adapter to use an ordinary function as a Policy.
+type PolicyFunc func(line []rune, index int, candidates []rune) rune
+
+// Resolve is a Policy interface implementation. It calls f(line, index,
+// candidates).
+func (f PolicyFunc) Resolve(line []rune, index int, candidates []rune) rune {
+	return f(line, index, candidates)
+}
+
+// PolicyKeep is a Policy that leaves the ambiguous characters as is.
+var PolicyKeep Policy = PolicyFunc(func(line []rune, index int, _ []rune) rune {
+	return line[index]
+})
+
+// PolicyDefault is a Policy that picks the first candidate. Which is the most
+// common old form in the dictionary. E.g. '辨' for '弁' and '藝' for '芸'.
+var PolicyDefault Policy = PolicyFunc(func(_ []rune, _ int, candidates []rune) rune {
+	return candidates[0]
+})
+
+// ----------------------------------------------------------------------------
+//  Type: wordPolicy
+// ----------------------------------------------------------------------------
+
+// wordPolicy is a Policy that consults a word-level table. See NewWordPolicy.
+type wordPolicy struct {
+	fallback Policy
+	words    map[string][]rune
+	maxLen   int
+}
+
+// NewWordPolicy returns a Policy that resolves the ambiguous characters by the
+// given word-level table. Such as DefaultKyuJitaiWords. The key is the word in
+// shinjitai and the value is the word in kyujitai.
+//
+// The longest word that contains the character is used. If no word matches or
+// the old character in the word is not a candidate, the fallback policy is
+// used. If fallback is nil, PolicyKeep is used.
+//
+// It returns an error if the number of characters of a key and its value
+// differ.
+func NewWordPolicy(words map[string]string, fallback Policy) (Policy, error) {
+	if fallback == nil {
+		fallback = PolicyKeep
+	}
+
+	policy := &wordPolicy{
+		fallback: fallback,
+		words:    make(map[string][]rune, len(words)),
+	}
+
+	for shinJitai, kyuJitai := range words {
+		oldChars := []rune(kyuJitai)
+		lenWord := len([]rune(shinJitai))
+
+		if lenWord != len(oldChars) {
+			return nil, errors.Errorf(
+				"length of the word mismatch: %s (%d chars) -> %s (%d chars)",
+				shinJitai, lenWord, kyuJitai, len(oldChars),
+			)
+		}
+
+		policy.words[shinJitai] = oldChars
+
+		if lenWord > policy.maxLen {
+			policy.maxLen = lenWord
+		}
+	}
+
+	return policy, nil
+}
+
+// Resolve is a Policy interface implementation.
+func (p *wordPolicy) Resolve(line []rune, index int, candidates []rune) rune {
+	for lenWord := p.maxLen; lenWord > 0; lenWord-- {
+		for start := index - lenWord + 1; start <= index; start++ {
+			if start < 0 || start+lenWord > len(line) {
+				continue
+			}
+
+			oldChars, ok := p.words[string(line[start:start+lenWord])]
+			if !ok {
+				continue
+			}
+
+			if chosen := oldChars[index-start]; containsRune(candidates, chosen) {
+				return chosen
+			}
+		}
+	}
+
+	return p.fallback.Resolve(line, index, candidates)
+}
+
+// ----------------------------------------------------------------------------
+//  Public functions
+// ----------------------------------------------------------------------------
+
+// ToKyuJitai converts the Joyo Kanji (shinjitai) in the input to the old forms
+// (kyujitai). It is the reverse conversion of FixStringAsJoyo.
+//
+// The characters that have several old forms (such as '弁' to '辨', '辯' and
+// '瓣') or existed as a different kanji before the simplification (such as '芸')
+// are resolved by the given policy and returned as a list of Ambiguity. If
+// policy is nil, PolicyKeep is used.
+//
+// The characters in the ignore list are left as is.
+func ToKyuJitai(input string, policy Policy) (string, []Ambiguity) {
+	var output bytes.Buffer
+
+	// Reading from strings.Reader and writing to bytes.Buffer never fail.
+	ambiguities, _ := ToKyuJitaiFile(strings.NewReader(input), &output, policy)
+
+	return output.String(), ambiguities
+}
+
+// ToKyuJitaiFile is similar to ToKyuJitai but for file. The input is processed
+// line by line so that the policy can refer to the surrounding characters.
+func ToKyuJitaiFile(input io.Reader, output io.Writer, policy Policy) ([]Ambiguity, error) {
+	if input == nil || output == nil {
+		return nil, errors.New("input or output is nil")
+	}
+
+	if policy == nil {
+		policy = PolicyKeep
+	}
+
+	var (
+		ambiguities []Ambiguity
+		errWrite    error
+		line        []rune
+		positions   []converter.Position
+	)
+
+	flush := func() {
+		if errWrite == nil {
+			ambiguities = append(ambiguities, lineToKyuJitai(line, positions, policy)...)
+			_, errWrite = io.WriteString(output, string(line))
+		}
+
+		line = line[:0]
+		positions = positions[:0]
+	}
+
+	err := converter.Scan(input, func(in rune, pos converter.Position) {
+		line = append(line, in)
+		positions = append(positions, pos)
+
+		if in == '\n' {
+			flush()
+		}
+	})
+	if err != nil {
+		return ambiguities, errors.Wrap(err, "failed to read the input")
+	}
+
+	flush()
+
+	return ambiguities, errors.Wrap(errWrite, "failed to write the output")
+}
+
+// ----------------------------------------------------------------------------
+//  Private functions
+// ----------------------------------------------------------------------------
+
+// containsRune returns true if the given list contains the character.
+func containsRune(list []rune, char rune) bool {
+	for _, item := range list {
+		if item == char {
+			return true
+		}
+	}
+
+	return false
+}
+
+// kyuJitaiCandidates returns the old forms of the given Joyo Kanji. The kanji
+// itself is appended if it also existed as a different old kanji.
+func kyuJitaiCandidates(char rune) []rune {
+	forms := kanjiDict.KyuJitai(char)
+	if len(forms) == 0 {
+		return nil
+	}
+
+	candidates := []rune(forms.String())
+
+	if _, ok := kanji.ShinJitaiAsOldFormMap[char]; ok {
+		candidates = append(candidates, char)
+	}
+
+	return candidates
+}
+
+// lineToKyuJitai converts the characters of the line to the old forms in place
+// and returns the ambiguities found. The positions are the ones of each
+// character in the line.
+func lineToKyuJitai(line []rune, positions []converter.Position, policy Policy) []Ambiguity {
+	var ambiguities []Ambiguity
+
+	// Keep the original line for the policy to refer to.
+	original := append([]rune(nil), line...)
+
+	for index, char := range original {
+		if _, ok := ignoreList[char]; ok {
+			continue
+		}
+
+		candidates := kyuJitaiCandidates(char)
+
+		switch len(candidates) {
+		case 0:
+			continue
+		case 1:
+			line[index] = candidates[0]
+
+			continue
+		}
+
+		line[index] = policy.Resolve(original, index, candidates)
+
+		ambiguities = append(ambiguities, Ambiguity{
+			Pos:        positions[index],
+			Candidates: candidates,
+			Char:       char,
+			Chosen:     line[index],
+		})
+	}
+
+	return ambiguities
+}
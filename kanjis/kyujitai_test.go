@@ -0,0 +1,199 @@
+package kanjis
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ----------------------------------------------------------------------------
+//  DefaultKyuJitaiWords
+// ----------------------------------------------------------------------------
+
+// This test ensures that the words in DefaultKyuJitaiWords are consistent. The
+// kyujitai word must be fixed back to the shinjitai word.
+func TestDefaultKyuJitaiWords_consistency(t *testing.T) {
+	for shinJitai, kyuJitai := range DefaultKyuJitaiWords {
+		assert.Equal(t, shinJitai, FixStringAsJoyo(kyuJitai),
+			"kyujitai word %s does not match to %s", kyuJitai, shinJitai)
+	}
+
+	_, err := NewWordPolicy(DefaultKyuJitaiWords, nil)
+	require.NoError(t, err)
+}
+
+// ----------------------------------------------------------------------------
+//  NewWordPolicy()
+// ----------------------------------------------------------------------------
+
+func TestNewWordPolicy_length_mismatch(t *testing.T) {
+	policy, err := NewWordPolicy(map[string]string{"弁論": "辯"}, PolicyDefault)
+
+	require.Error(t, err)
+	require.Nil(t, policy)
+	assert.Contains(t, err.Error(), "length of the word mismatch")
+}
+
+func TestNewWordPolicy_fallback(t *testing.T) {
+	for _, test := range []struct {
+		fallback Policy
+		input    string
+		expect   string
+	}{
+		{fallback: nil, input: "弁", expect: "弁"},
+		{fallback: PolicyKeep, input: "弁", expect: "弁"},
+		{fallback: PolicyDefault, input: "弁", expect: "辨"},
+		// Word match wins over the fallback
+		{fallback: PolicyDefault, input: "雄弁", expect: "雄辯"},
+		{fallback: PolicyKeep, input: "花弁", expect: "花瓣"},
+	} {
+		policy, err := NewWordPolicy(DefaultKyuJitaiWords, test.fallback)
+		require.NoError(t, err)
+
+		actual, _ := ToKyuJitai(test.input, policy)
+
+		assert.Equal(t, test.expect, actual, "input: %s", test.input)
+	}
+}
+
+// The old character in the word must be one of the candidates. Otherwise the
+// fallback policy is used.
+func TestNewWordPolicy_not_a_candidate(t *testing.T) {
+	policy, err := NewWordPolicy(map[string]string{"弁論": "体論"}, PolicyDefault)
+	require.NoError(t, err)
+
+	actual, _ := ToKyuJitai("弁論", policy)
+
+	assert.Equal(t, "辨論", actual)
+}
+
+// ----------------------------------------------------------------------------
+//  ToKyuJitai()
+// ----------------------------------------------------------------------------
+
+// Round trip of FixStringAsJoyo(ToKyuJitai(s)) must be identity on Joyo Kanji
+// only text with any policy.
+func TestToKyuJitai_round_trip(t *testing.T) {
+	var joyoOnly strings.Builder
+
+	for char, kanjiData := range kanjiDict {
+		if !kanjiData.IsKyuJitai {
+			joyoOnly.WriteRune(char)
+		}
+	}
+
+	wordPolicy, err := NewWordPolicy(DefaultKyuJitaiWords, PolicyDefault)
+	require.NoError(t, err)
+
+	for _, input := range []string{
+		joyoOnly.String(),
+		"国語の辞書で学校の図書館を探す。\n花弁と弁論と弁当。\n芸術と芸香。",
+	} {
+		for _, policy := range []Policy{nil, PolicyKeep, PolicyDefault, wordPolicy} {
+			converted, _ := ToKyuJitai(input, policy)
+
+			require.Equal(t, input, FixStringAsJoyo(converted))
+		}
+	}
+}
+
+// Every old form of every Joyo Kanji must be fixed back to the Joyo Kanji.
+func TestToKyuJitai_all_old_forms(t *testing.T) {
+	for char, kanjiData := range kanjiDict {
+		if kanjiData.IsKyuJitai {
+			continue
+		}
+
+		for _, form := range kanjiDict.KyuJitai(char) {
+			require.Equal(t, char, FixRuneAsJoyo(rune(form.Char)),
+				"old form %s of %s is not fixed back", form.Char, string(char))
+		}
+	}
+}
+
+func TestToKyuJitai_ambiguities(t *testing.T) {
+	policy, err := NewWordPolicy(DefaultKyuJitaiWords, PolicyKeep)
+	require.NoError(t, err)
+
+	actual, ambiguities := ToKyuJitai("学校の台帳。\n舞台と余", policy)
+
+	assert.Equal(t, "學校の臺帳。\n舞臺と余", actual)
+	require.Len(t, ambiguities, 3)
+
+	assert.Equal(t, '台', ambiguities[0].Char)
+	assert.Equal(t, '臺', ambiguities[0].Chosen)
+	assert.Equal(t, []rune{'臺', '台'}, ambiguities[0].Candidates)
+	assert.Equal(t, 1, ambiguities[0].Pos.Line)
+	assert.Equal(t, 4, ambiguities[0].Pos.Column)
+
+	assert.Equal(t, '臺', ambiguities[1].Chosen)
+	assert.Equal(t, 2, ambiguities[1].Pos.Line)
+	assert.Equal(t, 2, ambiguities[1].Pos.Column)
+
+	// No word matches and PolicyKeep leaves it as is
+	assert.Equal(t, '余', ambiguities[2].Char)
+	assert.Equal(t, '余', ambiguities[2].Chosen)
+	assert.Equal(t, []rune{'餘', '余'}, ambiguities[2].Candidates)
+}
+
+// '颱' is a different kanji from '台' and not an old form of it. Thus '台風'
+// is left as is.
+func TestToKyuJitai_not_typhoon(t *testing.T) {
+	policy, err := NewWordPolicy(DefaultKyuJitaiWords, PolicyKeep)
+	require.NoError(t, err)
+
+	actual, _ := ToKyuJitai("台風", policy)
+
+	assert.Equal(t, "台風", actual)
+	assert.False(t, IsKyuJitai('颱'))
+	assert.Equal(t, '颱', FixRuneAsJoyo('颱'))
+}
+
+func TestToKyuJitai_ignore(t *testing.T) {
+	defer ResetIgnore()
+
+	Ignore('学')
+
+	actual, _ := ToKyuJitai("学校", nil)
+
+	assert.Equal(t, "学校", actual)
+}
+
+// ----------------------------------------------------------------------------
+//  ToKyuJitaiFile()
+// ----------------------------------------------------------------------------
+
+func TestToKyuJitaiFile_nil_input(t *testing.T) {
+	ambiguities, err := ToKyuJitaiFile(nil, nil, nil)
+
+	require.Error(t, err)
+	require.Nil(t, ambiguities)
+	assert.Contains(t, err.Error(), "input or output is nil")
+}
+
+func TestToKyuJitaiFile_fail_read(t *testing.T) {
+	_, err := ToKyuJitaiFile(iotest.ErrReader(errors.New("forced error")), &bytes.Buffer{}, nil)
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to read the input")
+	assert.Contains(t, err.Error(), "forced error")
+}
+
+func TestToKyuJitaiFile_fail_write(t *testing.T) {
+	_, err := ToKyuJitaiFile(strings.NewReader("学校\n国語"), failWriter{}, nil)
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to write the output: forced error")
+}
+
+// failWriter is an io.Writer that always fails.
+type failWriter struct{}
+
+func (failWriter) Write(_ []byte) (int, error) {
+	return 0, errors.New("forced error")
+}
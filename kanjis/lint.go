@@ -0,0 +1,106 @@
+package kanjis
+
+import (
+	"io"
+	"strings"
+
+	"github.com/KEINOS/go-joyokanjis/kanjis/converter"
+	"github.com/KEINOS/go-joyokanjis/kanjis/kanji"
+	"github.com/pkg/errors"
+)
+
+// ----------------------------------------------------------------------------
+//  Type: Issue
+// ----------------------------------------------------------------------------
+
+// Issue is a record of a character that violates a lint rule.
+type Issue struct {
+	// Pos is the position of the character in the input.
+	Pos converter.Position `json:"pos"`
+	// Rule is the name of the violated rule.
+	Rule string `json:"rule"`
+	// Note is the additional information about the issue, if any.
+	Note string `json:"note,omitempty"`
+	// Char is the character that violates the rule.
+	Char rune `json:"char"`
+	// Suggestion is the suggested replacement of the character. 0 if none.
+	Suggestion rune `json:"suggestion,omitempty"`
+	// Grade is the school grade in which the character is taught. Set by the
+	// rules of the audience profiles only. See Profile.
+	Grade kanji.Grade `json:"grade,omitempty"`
+}
+
+// ----------------------------------------------------------------------------
+//  Type: Rule
+// ----------------------------------------------------------------------------
+
+// Rule is a lint rule to check the characters.
+type Rule struct {
+	// Check returns true if the given character violates the rule. The Pos,
+	// Rule and Char fields of the returned Issue are set by the caller.
+	Check func(char rune) (Issue, bool)
+	// Name is the name of the rule.
+	Name string
+}
+
+// RuleSimplifiedChinese is a lint rule that detects simplified Chinese
+// characters (简体字) which are not used in Japanese. Such as '说' and '门'.
+//
+// The Japanese form is suggested if the correspondence exists. E.g. '读' to '読'.
+var RuleSimplifiedChinese = Rule{
+	Name: "simplified-chinese",
+	Check: func(char rune) (Issue, bool) {
+		if !kanji.IsSimplifiedChinese(char) {
+			return Issue{}, false
+		}
+
+		issue := Issue{Note: "simplified Chinese character not used in Japanese"}
+
+		if japanese, ok := kanji.SimplifiedToJapanese(char); ok {
+			issue.Suggestion = japanese
+		}
+
+		return issue, true
+	},
+}
+
+// ----------------------------------------------------------------------------
+//  Public functions
+// ----------------------------------------------------------------------------
+
+// Classify returns the classification of the given character. Such as Joyo
+// Kanji, old kanji (kyujitai), simplified Chinese and non-Joyo Kanji (hyogai).
+func Classify(char rune) kanji.Class {
+	return kanjiDict.Classify(char)
+}
+
+// Lint reads the input and returns the issues of the characters that violate
+// the given rules. Issues are in the order of appearance.
+func Lint(input io.Reader, rules ...Rule) ([]Issue, error) {
+	var issues []Issue
+
+	err := converter.Scan(input, func(in rune, pos converter.Position) {
+		for _, rule := range rules {
+			issue, violated := rule.Check(in)
+			if !violated {
+				continue
+			}
+
+			issue.Pos = pos
+			issue.Rule = rule.Name
+			issue.Char = in
+
+			issues = append(issues, issue)
+		}
+	})
+
+	return issues, errors.Wrap(err, "failed to lint the input")
+}
+
+// LintString is similar to Lint but for string.
+func LintString(input string, rules ...Rule) []Issue {
+	// Reading from strings.Reader never fails.
+	issues, _ := Lint(strings.NewReader(input), rules...)
+
+	return issues
+}
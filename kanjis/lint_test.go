@@ -0,0 +1,80 @@
+package kanjis
+
+import (
+	"testing"
+
+	"github.com/KEINOS/go-joyokanjis/kanjis/converter"
+	"github.com/KEINOS/go-joyokanjis/kanjis/kanji"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ----------------------------------------------------------------------------
+//  Classify()
+// ----------------------------------------------------------------------------
+
+func TestClassify(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		char   rune
+		expect kanji.Class
+	}{
+		{'漢', kanji.ClassJoyo},
+		{'\uFA47', kanji.ClassKyuJitai}, // CJK Compatibility Ideograph of '漢'
+		{'亙', kanji.ClassKyuJitai},      // Non-joyo old kanji
+		{'读', kanji.ClassSimplifiedChinese},
+		{'们', kanji.ClassSimplifiedChinese},
+		{'薔', kanji.ClassHyogai},
+		{'\uFA0C', kanji.ClassHyogai}, // CJK Compatibility Ideograph
+		{'あ', kanji.ClassNotKanji},
+		{'a', kanji.ClassNotKanji},
+	} {
+		require.Equal(t, test.expect, Classify(test.char),
+			"Classify(%q) returned unexpected class", test.char)
+	}
+}
+
+// ----------------------------------------------------------------------------
+//  Lint()
+// ----------------------------------------------------------------------------
+
+func TestLint_nil_input(t *testing.T) {
+	t.Parallel()
+
+	issues, err := Lint(nil, RuleSimplifiedChinese)
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to lint the input: input is nil",
+		"it should contain the error reason")
+	assert.Nil(t, issues)
+}
+
+// ----------------------------------------------------------------------------
+//  LintString()
+// ----------------------------------------------------------------------------
+
+func TestLintString_simplified_chinese(t *testing.T) {
+	t.Parallel()
+
+	issues := LintString("我们说\n日本語を読む", RuleSimplifiedChinese)
+
+	require.Equal(t, []Issue{
+		{
+			Pos:  converter.Position{Offset: 3, Line: 1, Column: 2},
+			Rule: "simplified-chinese", Note: "simplified Chinese character not used in Japanese",
+			Char: '们', Suggestion: '們',
+		},
+		{
+			Pos:  converter.Position{Offset: 6, Line: 1, Column: 3},
+			Rule: "simplified-chinese", Note: "simplified Chinese character not used in Japanese",
+			Char: '说', Suggestion: '説',
+		},
+	}, issues)
+}
+
+func TestLintString_no_rules(t *testing.T) {
+	t.Parallel()
+
+	require.Empty(t, LintString("我们说"), "no issues should be reported without rules")
+}
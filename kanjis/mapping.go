@@ -0,0 +1,123 @@
+package kanjis
+
+import (
+	"bytes"
+	"io"
+	"strings"
+
+	"github.com/KEINOS/go-joyokanjis/kanjis/converter"
+	"github.com/pkg/errors"
+)
+
+// ----------------------------------------------------------------------------
+//  Type: Mapping
+// ----------------------------------------------------------------------------
+
+// Mapping is the direction to canonicalize the characters that are mapped
+// differently between JIS X 0208 (Unicode standard mapping) and CP932
+// (Windows-31J, Microsoft's Shift_JIS).
+//
+// Such as the "wave dash problem" (波ダッシュ問題), where the text round-tripped
+// through Windows systems turns '〜' (U+301C) into '～' (U+FF5E).
+type Mapping int
+
+const (
+	// MappingUnicode canonicalizes the characters to the JIS X 0208 mapping
+	// of Unicode. E.g. '～' (U+FF5E) to '〜' (U+301C).
+	MappingUnicode Mapping = iota
+	// MappingCP932 canonicalizes the characters to the ones that CP932 can
+	// encode. E.g. '〜' (U+301C) to '～' (U+FF5E).
+	MappingCP932
+)
+
+// mappingPairs is the list of known mapping discrepancies between JIS X 0208
+// and CP932. The key is the character in JIS X 0208 mapping and the value is
+// the one in CP932 mapping.
+var mappingPairs = map[rune]rune{
+	'〜': '～', // WAVE DASH 〜 <-> FULLWIDTH TILDE ～
+	'‖': '∥', // DOUBLE VERTICAL LINE ‖ <-> PARALLEL TO ∥
+	'−': '－', // MINUS SIGN − <-> FULLWIDTH HYPHEN-MINUS －
+	'¢': '￠', // CENT SIGN ¢ <-> FULLWIDTH CENT SIGN ￠
+	'£': '￡', // POUND SIGN £ <-> FULLWIDTH POUND SIGN ￡
+	'¬': '￢', // NOT SIGN ¬ <-> FULLWIDTH NOT SIGN ￢
+	'—': '―', // EM DASH — <-> HORIZONTAL BAR ―
+}
+
+// mappingPairsReverse is the reverse of mappingPairs. CP932 to JIS X 0208.
+var mappingPairsReverse = func() map[rune]rune {
+	reversed := make(map[rune]rune, len(mappingPairs))
+
+	for unicodeChar, cp932Char := range mappingPairs {
+		reversed[cp932Char] = unicodeChar
+	}
+
+	return reversed
+}()
+
+// ----------------------------------------------------------------------------
+//  Type: Change
+// ----------------------------------------------------------------------------
+
+// Change is a record of a character that was replaced during the conversion.
+type Change struct {
+	// Pos is the position of the character in the input.
+	Pos converter.Position `json:"pos"`
+	// From is the original character.
+	From rune `json:"from"`
+	// To is the character replaced with.
+	To rune `json:"to"`
+}
+
+// ----------------------------------------------------------------------------
+//  Public functions
+// ----------------------------------------------------------------------------
+
+// FixRuneMapping canonicalizes the given character to the given mapping
+// direction if the character is one of the known JIS X 0208/CP932 mapping
+// discrepancies. Otherwise, it returns the given character as is.
+func FixRuneMapping(char rune, mapping Mapping) rune {
+	table := mappingPairs
+	if mapping == MappingUnicode {
+		table = mappingPairsReverse
+	}
+
+	if fixed, ok := table[char]; ok {
+		return fixed
+	}
+
+	return char
+}
+
+// FixStringMapping is similar to FixRuneMapping but for string. It also returns
+// the list of changes made.
+func FixStringMapping(input string, mapping Mapping) (string, []Change) {
+	var output bytes.Buffer
+
+	// Reading from strings.Reader and writing to bytes.Buffer never fail.
+	changes, _ := FixFileMapping(strings.NewReader(input), &output, mapping)
+
+	return output.String(), changes
+}
+
+// FixFileMapping is similar to FixRuneMapping but for file. It also returns the
+// list of changes made.
+func FixFileMapping(input io.Reader, output io.Writer, mapping Mapping) ([]Change, error) {
+	if input == nil || output == nil {
+		return nil, errors.New("input or output is nil")
+	}
+
+	var changes []Change
+
+	tf := converter.NewMapper(func(in rune, pos converter.Position) string {
+		fixed := FixRuneMapping(in, mapping)
+		if fixed != in {
+			changes = append(changes, Change{Pos: pos, From: in, To: fixed})
+		}
+
+		return string(fixed)
+	})
+
+	err := tf.Convert(input, output)
+
+	return changes, errors.Wrap(err, "failed to convert the input to the output")
+}
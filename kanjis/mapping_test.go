@@ -0,0 +1,107 @@
+package kanjis
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/KEINOS/go-joyokanjis/kanjis/converter"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/encoding/japanese"
+)
+
+// ----------------------------------------------------------------------------
+//  FixRuneMapping()
+// ----------------------------------------------------------------------------
+
+// This test ensures that all the characters canonicalized to CP932 are
+// encodable in CP932 and the ones to Unicode are not.
+func TestFixRuneMapping_encodable_in_cp932(t *testing.T) {
+	t.Parallel()
+
+	encoder := japanese.ShiftJIS.NewEncoder() // WHATWG Shift_JIS, which is CP932
+
+	for unicodeChar, cp932Char := range mappingPairs {
+		require.Equal(t, cp932Char, FixRuneMapping(unicodeChar, MappingCP932))
+		require.Equal(t, unicodeChar, FixRuneMapping(cp932Char, MappingUnicode))
+
+		_, err := encoder.String(string(cp932Char))
+		assert.NoError(t, err, "%q (%U) should be encodable in CP932", cp932Char, cp932Char)
+
+		_, err = encoder.String(string(unicodeChar))
+		assert.Error(t, err, "%q (%U) should not be encodable in CP932", unicodeChar, unicodeChar)
+	}
+}
+
+func TestFixRuneMapping_as_is(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		char    rune
+		mapping Mapping
+	}{
+		{'a', MappingCP932},
+		{'あ', MappingCP932},
+		{'漢', MappingUnicode},
+		{'～', MappingCP932},   // already in CP932
+		{'〜', MappingUnicode}, // already in Unicode
+	} {
+		require.Equal(t, test.char, FixRuneMapping(test.char, test.mapping),
+			"%q should be returned as is", test.char)
+	}
+}
+
+// ----------------------------------------------------------------------------
+//  FixFileMapping()
+// ----------------------------------------------------------------------------
+
+func TestFixFileMapping_nil_output(t *testing.T) {
+	t.Parallel()
+
+	changes, err := FixFileMapping(strings.NewReader("〜"), nil, MappingCP932)
+
+	require.Error(t, err, "nil output should return an error")
+	assert.Contains(t, err.Error(), "input or output is nil",
+		"it should contain the error reason")
+	assert.Nil(t, changes, "it should not return changes on error")
+}
+
+func TestFixFileMapping_fail_during_read(t *testing.T) {
+	t.Parallel()
+
+	input := &DummyReader{
+		Count:        0,
+		ErrorOnCount: 1,
+	}
+
+	var output bytes.Buffer
+
+	_, err := FixFileMapping(input, &output, MappingCP932)
+
+	require.Error(t, err, "it should fail during the scan")
+	assert.Contains(t, err.Error(), "failed to convert the input to the output",
+		"it should contain the error reason")
+}
+
+// ----------------------------------------------------------------------------
+//  FixStringMapping()
+// ----------------------------------------------------------------------------
+
+func TestFixStringMapping_changes(t *testing.T) {
+	t.Parallel()
+
+	output, changes := FixStringMapping("1〜2\n−3", MappingCP932)
+
+	require.Equal(t, "1～2\n－3", output)
+	require.Equal(t, []Change{
+		{Pos: converter.Position{Offset: 1, Line: 1, Column: 2}, From: '〜', To: '～'},
+		{Pos: converter.Position{Offset: 6, Line: 2, Column: 1}, From: '−', To: '－'},
+	}, changes)
+
+	// Round trip
+	output, changes = FixStringMapping(output, MappingUnicode)
+
+	require.Equal(t, "1〜2\n−3", output)
+	require.Len(t, changes, 2)
+}
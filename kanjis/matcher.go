@@ -0,0 +1,200 @@
+package kanjis
+
+import (
+	"bufio"
+	"io"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+)
+
+// ----------------------------------------------------------------------------
+//  Type: Match
+// ----------------------------------------------------------------------------
+
+// Match is a byte range of an occurrence found by Matcher in the unmodified
+// input. The matched text is input[Start:End].
+type Match struct {
+	// Start is the byte offset of the first character of the occurrence.
+	Start int64 `json:"start"`
+	// End is the byte offset next to the last character of the occurrence.
+	End int64 `json:"end"`
+}
+
+// ----------------------------------------------------------------------------
+//  Type: Matcher
+// ----------------------------------------------------------------------------
+
+// Matcher finds the occurrences of a pattern in the input modulo old kanji
+// (kyujitai), variant kanji (itaiji) and compatibility ideographs. Such as the
+// pattern "学校" matches "學校" as well.
+//
+// Since the input is not normalized, the byte ranges of the matches point to
+// the original text. It is safe for concurrent use.
+type Matcher struct {
+	// pattern is the folded pattern.
+	pattern []rune
+	// failure is the failure function (partial match table) of the pattern for
+	// the Knuth-Morris-Pratt algorithm.
+	failure []int
+	opts    FoldOption
+}
+
+// matchUnit is a folding unit of the input. Usually a character, or a halfwidth
+// katakana with the following halfwidth voiced sound mark.
+type matchUnit struct {
+	start int64
+	end   int64
+	char  rune
+}
+
+// NewMatcher returns a new Matcher for the given pattern. The options are the
+// same as EqualFold. E.g. FoldKana to also match "ガッコウ" with "がっこう".
+//
+// It returns an error if the pattern is empty.
+func NewMatcher(pattern string, opts FoldOption) (*Matcher, error) {
+	if pattern == "" {
+		return nil, errors.New("pattern is empty")
+	}
+
+	onceVariant.Do(buildVariantClasses)
+
+	folded := make([]rune, 0, utf8.RuneCountInString(pattern))
+
+	for len(pattern) > 0 {
+		char, size := nextFolded(pattern, opts)
+
+		folded = append(folded, char)
+		pattern = pattern[size:]
+	}
+
+	return &Matcher{
+		pattern: folded,
+		failure: failureFunction(folded),
+		opts:    opts,
+	}, nil
+}
+
+// ----------------------------------------------------------------------------
+//  Methods
+// ----------------------------------------------------------------------------
+
+// FindAll reads the input until EOF and returns all the non-overlapping
+// occurrences of the pattern in the order of appearance.
+func (m *Matcher) FindAll(input io.Reader) ([]Match, error) {
+	if input == nil {
+		return nil, errors.New("input is nil")
+	}
+
+	var (
+		matches []Match
+		pending *matchUnit
+		offset  int64
+		state   int
+		count   int
+		// starts is the ring buffer of the start offsets of the last units.
+		starts = make([]int64, len(m.pattern))
+	)
+
+	feed := func(unit matchUnit) {
+		starts[count%len(starts)] = unit.start
+		count++
+
+		state = m.next(state, foldRune(unit.char, m.opts))
+		if state < len(m.pattern) {
+			return
+		}
+
+		matches = append(matches, Match{
+			Start: starts[(count-len(m.pattern))%len(starts)],
+			End:   unit.end,
+		})
+		state = 0
+	}
+
+	reader := bufio.NewReader(input)
+
+	for {
+		char, size, err := reader.ReadRune()
+		if err != nil {
+			if !errors.Is(err, io.EOF) {
+				return matches, errors.Wrap(err, "failed to read the input")
+			}
+
+			break
+		}
+
+		start := offset
+		offset += int64(size)
+
+		// Compose the halfwidth voiced sound mark with the pending katakana.
+		if pending != nil && m.opts&FoldWidth != 0 && isHalfwidthMark(char) {
+			if composed, ok := composeVoiced(foldWidth(pending.char), char); ok {
+				pending.char = composed
+				pending.end = offset
+
+				continue
+			}
+		}
+
+		if pending != nil {
+			feed(*pending)
+		}
+
+		pending = &matchUnit{start: start, end: offset, char: char}
+	}
+
+	if pending != nil {
+		feed(*pending)
+	}
+
+	return matches, nil
+}
+
+// FindAllString is similar to FindAll but for string.
+func (m *Matcher) FindAllString(input string) []Match {
+	// Reading from strings.Reader never fails.
+	matches, _ := m.FindAll(strings.NewReader(input))
+
+	return matches
+}
+
+// next returns the next state of the Knuth-Morris-Pratt automaton. The state is
+// the length of the matched prefix of the pattern.
+func (m *Matcher) next(state int, char rune) int {
+	for state > 0 && m.pattern[state] != char {
+		state = m.failure[state-1]
+	}
+
+	if m.pattern[state] == char {
+		state++
+	}
+
+	return state
+}
+
+// ----------------------------------------------------------------------------
+//  Private functions
+// ----------------------------------------------------------------------------
+
+// failureFunction returns the failure function of the pattern. The i-th value
+// is the length of the longest proper prefix of pattern[:i+1] which is also its
+// suffix.
+func failureFunction(pattern []rune) []int {
+	failure := make([]int, len(pattern))
+
+	for i, matched := 1, 0; i < len(pattern); i++ {
+		for matched > 0 && pattern[i] != pattern[matched] {
+			matched = failure[matched-1]
+		}
+
+		if pattern[i] == pattern[matched] {
+			matched++
+		}
+
+		failure[i] = matched
+	}
+
+	return failure
+}
@@ -0,0 +1,95 @@
+package kanjis
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ----------------------------------------------------------------------------
+//  NewMatcher()
+// ----------------------------------------------------------------------------
+
+func TestNewMatcher_empty_pattern(t *testing.T) {
+	matcher, err := NewMatcher("", FoldVariant)
+
+	require.Error(t, err)
+	require.Nil(t, matcher)
+	assert.Contains(t, err.Error(), "pattern is empty")
+}
+
+// ----------------------------------------------------------------------------
+//  Matcher.FindAll()
+// ----------------------------------------------------------------------------
+
+func TestMatcher_FindAll(t *testing.T) {
+	for _, test := range []struct {
+		pattern string
+		input   string
+		expect  []string
+		opts    FoldOption
+	}{
+		// Old and new kanji in both the pattern and the input
+		{pattern: "学校", input: "學校と学校と學校", expect: []string{"學校", "学校", "學校"}},
+		{pattern: "學校", input: "学校の學生", expect: []string{"学校"}},
+		// Variant kanji and compatibility ideographs
+		{pattern: "渡辺", input: "渡邉さんと渡邊さん", expect: []string{"渡邉", "渡邊"}},
+		{pattern: "漢字", input: "\uFA47字", expect: []string{"\uFA47字"}},
+		// Kana
+		{pattern: "ガッコウ", input: "がっこう", expect: nil},
+		{pattern: "ガッコウ", input: "がっこう", expect: []string{"がっこう"}, opts: FoldKana},
+		// Width with the halfwidth voiced sound mark
+		{pattern: "ガッコウ", input: "ｶﾞｯｺｳとガッコウ", expect: []string{"ｶﾞｯｺｳ", "ガッコウ"}, opts: FoldWidth},
+		{pattern: "ｶﾞ", input: "カガｶﾞ", expect: []string{"ガ", "ｶﾞ"}, opts: FoldWidth},
+		// Non-overlapping and partial matches
+		{pattern: "ああ", input: "あああああ", expect: []string{"ああ", "ああ"}},
+		{pattern: "あい", input: "ああい", expect: []string{"あい"}},
+		{pattern: "學", input: "", expect: nil},
+	} {
+		matcher, err := NewMatcher(test.pattern, test.opts)
+		require.NoError(t, err)
+
+		var actual []string
+
+		for _, match := range matcher.FindAllString(test.input) {
+			actual = append(actual, test.input[match.Start:match.End])
+		}
+
+		assert.Equal(t, test.expect, actual, "pattern: %q, input: %q", test.pattern, test.input)
+	}
+}
+
+func TestMatcher_FindAll_offset(t *testing.T) {
+	matcher, err := NewMatcher("学校", FoldVariant)
+	require.NoError(t, err)
+
+	matches, err := matcher.FindAll(iotest.OneByteReader(strings.NewReader("a學校b")))
+	require.NoError(t, err)
+
+	assert.Equal(t, []Match{{Start: 1, End: 7}}, matches)
+}
+
+func TestMatcher_FindAll_nil_input(t *testing.T) {
+	matcher, err := NewMatcher("学校", FoldVariant)
+	require.NoError(t, err)
+
+	matches, err := matcher.FindAll(nil)
+
+	require.Error(t, err)
+	require.Nil(t, matches)
+	assert.Contains(t, err.Error(), "input is nil")
+}
+
+func TestMatcher_FindAll_fail_read(t *testing.T) {
+	matcher, err := NewMatcher("学校", FoldVariant)
+	require.NoError(t, err)
+
+	_, err = matcher.FindAll(iotest.ErrReader(errors.New("forced error")))
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to read the input: forced error")
+}
@@ -0,0 +1,89 @@
+package kanjis
+
+// nameKana is the list of the kana that can be used for personal names in the
+// family register. Which are the kana of the appended table 2 of the Family
+// Register Act Enforcement Regulations (戸籍法施行規則 別表第二) with the voiced,
+// semi-voiced and small forms. Including 'ゔ' and 'ヴ' as the voiced 'う' and
+// 'ウ'.
+//
+// The small 'ゕ', 'ゖ', 'ヵ' and 'ヶ', the voiced 'ヷ' to 'ヺ' and the hentaigana
+// are not in the list.
+var nameKana = func() map[rune]struct{} {
+	const (
+		hiragana = "" +
+			"ぁあぃいぅうぇえぉおかがきぎくぐけげこごさざしじすずせぜそぞただちぢっつづてでとどなにぬねの" +
+			"はばぱひびぴふぶぷへべぺほぼぽまみむめもゃやゅゆょよらりるれろゎわゐゑをんゔ"
+		katakana = "" +
+			"ァアィイゥウェエォオカガキギクグケゲコゴサザシジスズセゼソゾタダチヂッツヅテデトドナニヌネノ" +
+			"ハバパヒビピフブプヘベペホボポマミムメモャヤュユョヨラリルレロヮワヰヱヲンヴ"
+	)
+
+	kana := make(map[rune]struct{}, 168)
+
+	for _, char := range hiragana + katakana {
+		kana[char] = struct{}{}
+	}
+
+	return kana
+}()
+
+// nameMarks is the list of the marks other than kana and kanji that can be used
+// for personal names in the family register.
+var nameMarks = map[rune]struct{}{
+	'ー': {}, // Prolonged sound mark
+	'々': {}, // Iteration mark for kanji
+	'ゝ': {}, // Iteration mark for hiragana
+	'ゞ': {}, // Voiced iteration mark for hiragana
+	'ヽ': {}, // Iteration mark for katakana
+	'ヾ': {}, // Voiced iteration mark for katakana
+}
+
+// ----------------------------------------------------------------------------
+//  Public functions
+// ----------------------------------------------------------------------------
+
+// IsRegistrableInName returns true if all the characters of the given name can
+// be used for personal names in the family register (戸籍). It also returns the
+// offending characters in the order of appearance if any. Note that an empty
+// name returns true.
+//
+// As of the Family Register Act Enforcement Regulations (戸籍法施行規則 第60条),
+// the following characters are registrable:
+//
+//   - Joyo Kanji (常用漢字) in shinjitai.
+//   - Jinmeiyo Kanji (人名用漢字). Including the old forms of Joyo Kanji in the
+//     list. E.g. '亞'.
+//   - Hiragana and katakana. Excluding the small 'ゕ', 'ゖ', 'ヵ' and 'ヶ', the
+//     voiced 'ヷ' to 'ヺ' and hentaigana.
+//   - Prolonged sound mark ('ー') and iteration marks ('々', 'ゝ', 'ゞ', 'ヽ' and
+//     'ヾ').
+func IsRegistrableInName(name string) (bool, []rune) {
+	var offending []rune
+
+	for _, char := range name {
+		if !isRegistrableInName(char) {
+			offending = append(offending, char)
+		}
+	}
+
+	return len(offending) == 0, offending
+}
+
+// ----------------------------------------------------------------------------
+//  Private functions
+// ----------------------------------------------------------------------------
+
+// isRegistrableInName returns true if the given character can be used for
+// personal names in the family register.
+func isRegistrableInName(char rune) bool {
+	if _, ok := nameMarks[char]; ok {
+		return true
+	}
+
+	if _, ok := nameKana[char]; ok {
+		return true
+	}
+
+	return kanjiDict.IsJoyoKanji(char) ||
+		jinmeiyoDict.IsJinmeiyoKanji(char)
+}
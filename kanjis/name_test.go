@@ -0,0 +1,109 @@
+package kanjis
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ----------------------------------------------------------------------------
+//  Jinmeiyo Kanji list
+// ----------------------------------------------------------------------------
+
+// As of the 2017 revision, there are 863 Jinmeiyo Kanji. 651 in the first part
+// of the table and 212 old forms of Joyo Kanji in the second part.
+func TestLenJinmeiyo(t *testing.T) {
+	require.Equal(t, 863, LenJinmeiyo())
+
+	numJoyoVariant := 0
+
+	for _, jinmeiyo := range jinmeiyoDict {
+		if jinmeiyo.IsJoyoVariant {
+			numJoyoVariant++
+		}
+	}
+
+	require.Equal(t, 212, numJoyoVariant)
+}
+
+func TestIsJinmeiyoKanji_not_joyo(t *testing.T) {
+	for char, jinmeiyo := range jinmeiyoDict {
+		require.False(t, IsJoyoKanji(char),
+			"%s (%U) is a Joyo Kanji", string(char), char)
+
+		if jinmeiyo.IsJoyoVariant {
+			require.True(t, IsJoyoKanji(rune(jinmeiyo.ShinJitai)),
+				"the new form of %s (%U) is not a Joyo Kanji", string(char), char)
+		}
+	}
+}
+
+// The old forms must be equal to the new form in EqualFold.
+func TestIsJinmeiyoKanji_equal_fold(t *testing.T) {
+	for char, jinmeiyo := range jinmeiyoDict {
+		if jinmeiyo.ShinJitai == 0 {
+			continue
+		}
+
+		require.True(t, EqualFold(string(char), jinmeiyo.ShinJitai.String(), FoldVariant),
+			"%s (%U) is not a variant of %s", string(char), char, jinmeiyo.ShinJitai)
+	}
+}
+
+func TestIsJinmeiyoKanji(t *testing.T) {
+	for _, test := range []struct {
+		char   rune
+		expect bool
+	}{
+		{char: '凛', expect: true},
+		{char: '凜', expect: true},
+		{char: '亞', expect: true},
+		{char: '巫', expect: true},
+		{char: '渾', expect: true},
+		{char: '\uFA46', expect: true}, // Compatibility ideograph of '渚'
+		{char: '亜', expect: false},     // Joyo Kanji
+		{char: '兔', expect: false},
+		{char: 'あ', expect: false},
+	} {
+		assert.Equal(t, test.expect, IsJinmeiyoKanji(test.char), "char: %s", string(test.char))
+	}
+}
+
+// ----------------------------------------------------------------------------
+//  IsRegistrableInName()
+// ----------------------------------------------------------------------------
+
+func TestIsRegistrableInName(t *testing.T) {
+	for _, test := range []struct {
+		name      string
+		offending []rune
+	}{
+		{name: "山田太郎"},
+		{name: "佐々木凜"},
+		{name: "さくら"},
+		{name: "マリー"},
+		{name: "いすゞ"},
+		{name: ""},
+		{name: "渡邊", offending: []rune{'邊'}},
+		{name: "渡邉", offending: []rune{'邉'}},
+		{name: "髙橋", offending: []rune{'髙'}},
+		{name: "Mary", offending: []rune{'M', 'a', 'r', 'y'}},
+		{name: "ﾏﾘｰ", offending: []rune{'ﾏ', 'ﾘ', 'ｰ'}},
+		{name: "美・子", offending: []rune{'・'}},
+		{name: "ヴィオラ"},
+		{name: "ゐゑヰヱ"},
+		{name: "ぁっゎァッヮ"},
+		{name: "ヶ丘", offending: []rune{'ヶ'}},
+		{name: "ヵゕゖ", offending: []rune{'ヵ', 'ゕ', 'ゖ'}},
+		{name: "ヷヸヹヺ", offending: []rune{'ヷ', 'ヸ', 'ヹ', 'ヺ'}},
+		{name: "ゟヿ", offending: []rune{'ゟ', 'ヿ'}},             // Digraphs
+		{name: "ㇰ", offending: []rune{'ㇰ'}},                   // Katakana phonetic extension
+		{name: "\U0001B001", offending: []rune{'\U0001B001'}}, // Hentaigana
+	} {
+		ok, offending := IsRegistrableInName(test.name)
+
+		assert.Equal(t, len(test.offending) == 0, ok, "name: %s", test.name)
+		assert.Equal(t, test.offending, offending, "name: %s", test.name)
+	}
+}
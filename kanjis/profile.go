@@ -0,0 +1,130 @@
+package kanjis
+
+import (
+	"strconv"
+
+	"github.com/KEINOS/go-joyokanjis/kanjis/kanji"
+)
+
+// ----------------------------------------------------------------------------
+//  Type: Profile
+// ----------------------------------------------------------------------------
+
+// Profile is an audience profile that defines the kanji the readers are
+// expected to know. Such as the kanji taught by the 3rd grade of elementary
+// school for children's content.
+//
+// Use Profile.Rule with Lint to flag the kanji above the level.
+type Profile struct {
+	name string
+	// maxGrade is the highest school grade of the kanji allowed.
+	maxGrade kanji.Grade
+	// jinmeiyo allows the Jinmeiyo Kanji as well.
+	jinmeiyo bool
+}
+
+// Predefined profiles.
+var (
+	// Joyo is the profile of the Joyo Kanji (常用漢字) for general use. Which are
+	// the kanji taught by the end of the secondary school as well.
+	Joyo = Profile{name: "joyo", maxGrade: kanji.GradeSecondary}
+	// JoyoJinmeiyo is the profile of the Joyo Kanji and the Jinmeiyo Kanji
+	// (人名用漢字). Suitable for the content with personal names.
+	JoyoJinmeiyo = Profile{name: "joyo+jinmeiyo", maxGrade: kanji.GradeSecondary, jinmeiyo: true}
+)
+
+// Grade returns the profile of the kanji taught by the given grade of the
+// elementary school, based on the Kyoiku Kanji table (学年別漢字配当表) of the
+// course of study notified in 2017 and in effect since April 2020.
+// E.g. Grade(3) allows the 440 kanji of grades 1 to 3.
+//
+// The grade is clamped to 1 to 6.
+func Grade(grade int) Profile {
+	switch {
+	case grade < 1:
+		grade = 1
+	case grade > 6:
+		grade = 6
+	}
+
+	return Profile{
+		name:     "grade-" + strconv.Itoa(grade),
+		maxGrade: kanji.Grade(grade),
+	}
+}
+
+// ----------------------------------------------------------------------------
+//  Methods
+// ----------------------------------------------------------------------------
+
+// Allows returns true if the given character is known to the audience. The
+// characters other than kanji, such as kana and ASCII, are always allowed.
+func (p Profile) Allows(char rune) bool {
+	if kanjiDict.Classify(char) == kanji.ClassNotKanji {
+		return true
+	}
+
+	if grade := kanjiDict.GradeOf(char); grade != kanji.GradeNone && grade <= p.maxGrade {
+		return true
+	}
+
+	return p.jinmeiyo && jinmeiyoDict.IsJinmeiyoKanji(char)
+}
+
+// Name returns the name of the profile. E.g. "grade-3", "joyo" and
+// "joyo+jinmeiyo".
+func (p Profile) Name() string {
+	return p.name
+}
+
+// Rule returns the lint rule that flags the kanji above the level of the
+// profile. The rule name is "profile-" followed by the profile name and the
+// Grade field of the issue is the actual grade of the kanji.
+//
+// The Joyo Kanji is suggested for the old forms (kyujitai) if it is allowed.
+func (p Profile) Rule() Rule {
+	return Rule{
+		Name: "profile-" + p.name,
+		Check: func(char rune) (Issue, bool) {
+			if p.Allows(char) {
+				return Issue{}, false
+			}
+
+			grade := kanjiDict.GradeOf(char)
+			issue := Issue{Grade: grade, Note: gradeNote(grade)}
+
+			if fixed := FixRuneAsJoyo(char); fixed != char && p.Allows(fixed) {
+				issue.Suggestion = fixed
+			}
+
+			return issue, true
+		},
+	}
+}
+
+// ----------------------------------------------------------------------------
+//  Public functions
+// ----------------------------------------------------------------------------
+
+// GradeOf returns the school grade in which the given kanji is taught. It is 1
+// to 6 for the Kyoiku Kanji (教育漢字), kanji.GradeSecondary for the other Joyo
+// Kanji and kanji.GradeNone for the rest.
+func GradeOf(char rune) kanji.Grade {
+	return kanjiDict.GradeOf(char)
+}
+
+// ----------------------------------------------------------------------------
+//  Private functions
+// ----------------------------------------------------------------------------
+
+// gradeNote returns the note of the issue for the given grade.
+func gradeNote(grade kanji.Grade) string {
+	switch {
+	case grade.IsKyoiku():
+		return "taught in grade " + strconv.Itoa(int(grade))
+	case grade == kanji.GradeSecondary:
+		return "taught in secondary school"
+	}
+
+	return "not a Joyo Kanji"
+}
@@ -0,0 +1,136 @@
+package kanjis
+
+import (
+	"testing"
+
+	"github.com/KEINOS/go-joyokanjis/kanjis/converter"
+	"github.com/KEINOS/go-joyokanjis/kanjis/kanji"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ----------------------------------------------------------------------------
+//  GradeOf()
+// ----------------------------------------------------------------------------
+
+// This test ensures the number of kanji per grade and that all the Kyoiku Kanji
+// are Joyo Kanji.
+func TestGradeOf_count(t *testing.T) {
+	counts := make(map[kanji.Grade]int)
+
+	for char := range kanjiDict {
+		counts[GradeOf(char)]++
+	}
+
+	expect := map[kanji.Grade]int{
+		1: 80, 2: 160, 3: 200, 4: 202, 5: 193, 6: 191,
+		kanji.GradeSecondary: 2136 - 1026,
+	}
+
+	for grade, count := range expect {
+		assert.Equal(t, count, counts[grade], "grade: %s", grade)
+	}
+}
+
+func TestGradeOf(t *testing.T) {
+	for _, test := range []struct {
+		char   rune
+		expect kanji.Grade
+	}{
+		{char: '一', expect: 1},
+		{char: '学', expect: 1},
+		{char: '岡', expect: 4}, // Moved from secondary in 2020
+		{char: '城', expect: 4}, // Moved from grade 6 in 2020
+		{char: '憲', expect: 6},
+		{char: '亜', expect: kanji.GradeSecondary},
+		{char: '學', expect: kanji.GradeNone},
+		{char: '薔', expect: kanji.GradeNone},
+		{char: 'あ', expect: kanji.GradeNone},
+	} {
+		assert.Equal(t, test.expect, GradeOf(test.char), "kanji: %s", string(test.char))
+	}
+}
+
+// ----------------------------------------------------------------------------
+//  Grade()
+// ----------------------------------------------------------------------------
+
+func TestGrade_clamp(t *testing.T) {
+	assert.Equal(t, "grade-1", Grade(0).Name())
+	assert.Equal(t, "grade-6", Grade(7).Name())
+	assert.Equal(t, Grade(6).Allows('憲'), Grade(99).Allows('憲'))
+}
+
+// ----------------------------------------------------------------------------
+//  Profile.Allows()
+// ----------------------------------------------------------------------------
+
+func TestProfile_Allows(t *testing.T) {
+	for _, test := range []struct {
+		profile Profile
+		char    rune
+		expect  bool
+	}{
+		{profile: Grade(1), char: '学', expect: true},
+		{profile: Grade(1), char: '漢', expect: false},
+		{profile: Grade(3), char: '漢', expect: true},
+		{profile: Grade(3), char: 'ア', expect: true},
+		{profile: Grade(3), char: 'a', expect: true},
+		{profile: Grade(6), char: '亜', expect: false},
+		{profile: Joyo, char: '亜', expect: true},
+		{profile: Joyo, char: '學', expect: false},
+		{profile: Joyo, char: '凜', expect: false},
+		{profile: JoyoJinmeiyo, char: '凜', expect: true},
+		{profile: JoyoJinmeiyo, char: '亞', expect: true},
+		{profile: JoyoJinmeiyo, char: '薔', expect: false},
+	} {
+		assert.Equal(t, test.expect, test.profile.Allows(test.char),
+			"profile: %s, kanji: %s", test.profile.Name(), string(test.char))
+	}
+}
+
+// ----------------------------------------------------------------------------
+//  Profile.Rule()
+// ----------------------------------------------------------------------------
+
+func TestProfile_Rule(t *testing.T) {
+	issues := LintString("學校で漢字と亜鉛を習う", Grade(2).Rule())
+
+	require.Equal(t, []Issue{
+		{
+			Pos:  converter.Position{Offset: 0, Line: 1, Column: 1},
+			Rule: "profile-grade-2", Note: "not a Joyo Kanji",
+			Char: '學', Suggestion: '学', Grade: kanji.GradeNone,
+		},
+		{
+			Pos:  converter.Position{Offset: 9, Line: 1, Column: 4},
+			Rule: "profile-grade-2", Note: "taught in grade 3",
+			Char: '漢', Grade: 3,
+		},
+		{
+			Pos:  converter.Position{Offset: 18, Line: 1, Column: 7},
+			Rule: "profile-grade-2", Note: "taught in secondary school",
+			Char: '亜', Grade: kanji.GradeSecondary,
+		},
+		{
+			Pos:  converter.Position{Offset: 21, Line: 1, Column: 8},
+			Rule: "profile-grade-2", Note: "taught in secondary school",
+			Char: '鉛', Grade: kanji.GradeSecondary,
+		},
+		{
+			Pos:  converter.Position{Offset: 27, Line: 1, Column: 10},
+			Rule: "profile-grade-2", Note: "taught in grade 3",
+			Char: '習', Grade: 3,
+		},
+	}, issues)
+}
+
+func TestProfile_Rule_no_suggestion_above_level(t *testing.T) {
+	// '學' is fixed to '学' (grade 1) but U+FA47 is fixed to '漢' (grade 3)
+	// which is above grade 1.
+	issues := LintString("學\uFA47", Grade(1).Rule())
+
+	require.Len(t, issues, 2)
+	assert.Equal(t, '学', issues[0].Suggestion)
+	assert.Zero(t, issues[1].Suggestion, "suggestion above the level should not be set")
+}
@@ -0,0 +1,82 @@
+package kanjis
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/KEINOS/go-joyokanjis/kanjis/kanji"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ----------------------------------------------------------------------------
+//  FindByReading()
+// ----------------------------------------------------------------------------
+
+func TestFindByReading(t *testing.T) {
+	found := FindByReading("ガク", kanji.ReadingOn)
+
+	require.Contains(t, found, '学')
+	require.Contains(t, found, '楽')
+	require.NotContains(t, found, '學', "old forms should not be returned")
+
+	assert.Equal(t, found, FindByReading("がく", kanji.ReadingOn|kanji.ReadingFoldKana))
+
+	prefix := FindByReading("たの", kanji.ReadingKun|kanji.ReadingPrefix)
+
+	assert.Contains(t, prefix, '楽')
+	assert.Contains(t, prefix, '頼')
+
+	assert.Equal(t, []rune{'楽'}, FindByReading("たのしい", kanji.ReadingExample))
+}
+
+// This test ensures that the index returns the same results as the linear scan
+// of the dictionary.
+func TestFindByReading_same_as_dict(t *testing.T) {
+	for _, reading := range []string{"ガク", "がく", "セイ", "たの", "うえ", "い-きる", "か", "シ", "ア"} {
+		for _, opts := range []kanji.ReadingOption{
+			0,
+			kanji.ReadingOn,
+			kanji.ReadingKun | kanji.ReadingExample,
+			kanji.ReadingPrefix,
+			kanji.ReadingPrefix | kanji.ReadingFoldKana,
+		} {
+			require.Equal(t, kanjiDict.FindByReading(reading, opts), FindByReading(reading, opts),
+				"reading: %q, opts: %b", reading, opts)
+		}
+	}
+}
+
+func TestFindByReading_concurrent(t *testing.T) {
+	expect := kanjiDict.FindByReading("コウ", 0)
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			assert.Equal(t, expect, FindByReading("コウ", 0))
+		}()
+	}
+
+	wg.Wait()
+}
+
+// ----------------------------------------------------------------------------
+//  Readings()
+// ----------------------------------------------------------------------------
+
+func TestReadings(t *testing.T) {
+	readings := Readings('楽')
+
+	require.NotEmpty(t, readings)
+	assert.Equal(t, "ガク", readings[0].String())
+	assert.Equal(t, kanji.KindOn, readings[0].Kind)
+	assert.Equal(t, readings, Readings('樂'), "old forms should return the readings of the new form")
+
+	assert.Nil(t, Readings('凜'), "hyogai kanji should return nil")
+	assert.Nil(t, Readings('A'), "non-kanji should return nil")
+}
@@ -0,0 +1,312 @@
+package kanjis
+
+import (
+	"fmt"
+	"math/bits"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Predefined sets of the embedded dictionaries. They are built on the package
+// initialization.
+var (
+	// JoyoSet is the set of the 2,136 Joyo Kanji (常用漢字).
+	JoyoSet Set
+	// KyuJitaiSet is the set of the old forms (kyujitai, 旧字体) of Joyo Kanji
+	// registered in the dictionary. Including the CJK Compatibility Ideographs.
+	KyuJitaiSet Set
+	// JinmeiyoSet is the set of the Jinmeiyo Kanji (人名用漢字). Including the old
+	// forms of Joyo Kanji that can be used for personal names.
+	JinmeiyoSet Set
+	// JoyoTable is the range table of JoyoSet. E.g. unicode.Is(JoyoTable, r).
+	JoyoTable *unicode.RangeTable
+)
+
+// ----------------------------------------------------------------------------
+//  Type: Set
+// ----------------------------------------------------------------------------
+
+// Set is an immutable set of characters backed by a bitset. The zero value is
+// an empty set. The methods that combine sets return a new set and never modify
+// the receiver.
+type Set struct {
+	// words is the bitset. The n-th bit is set if rune(n) is in the set.
+	words []uint64
+	// count is the number of characters in the set.
+	count int
+}
+
+// NewSet returns a new set of the given characters. Invalid runes (such as the
+// negative values) are ignored.
+func NewSet(chars ...rune) Set {
+	var maxChar rune = -1
+
+	for _, char := range chars {
+		if char <= unicode.MaxRune && char > maxChar {
+			maxChar = char
+		}
+	}
+
+	if maxChar < 0 {
+		return Set{}
+	}
+
+	set := Set{words: make([]uint64, int(maxChar)/64+1)}
+
+	for _, char := range chars {
+		if char < 0 || char > unicode.MaxRune {
+			continue
+		}
+
+		index, bit := int(char)/64, uint64(1)<<(uint(char)%64)
+
+		if set.words[index]&bit == 0 {
+			set.words[index] |= bit
+			set.count++
+		}
+	}
+
+	return set
+}
+
+// GradeSet returns the set of the kanji taught by the given grade of the
+// elementary school. E.g. GradeSet(3) is the 440 kanji of grades 1 to 3. The
+// grade is clamped to 1 to 6 the same as Grade.
+func GradeSet(grade int) Set {
+	profile := Grade(grade)
+
+	var chars []rune
+
+	JoyoSet.Each(func(char rune) bool {
+		if GradeOf(char) <= profile.maxGrade {
+			chars = append(chars, char)
+		}
+
+		return true
+	})
+
+	return NewSet(chars...)
+}
+
+// ----------------------------------------------------------------------------
+//  Methods
+// ----------------------------------------------------------------------------
+
+// Contains returns true if the given character is in the set.
+func (s Set) Contains(char rune) bool {
+	if char < 0 {
+		return false
+	}
+
+	index := int(char) / 64
+
+	return index < len(s.words) && s.words[index]&(uint64(1)<<(uint(char)%64)) != 0
+}
+
+// Difference returns a new set of the characters in s but not in the other.
+func (s Set) Difference(other Set) Set {
+	return s.combine(other, func(a, b uint64) uint64 { return a &^ b })
+}
+
+// Each calls fn for each character in the set in ascending order of the code
+// point. It stops the iteration if fn returns false.
+func (s Set) Each(fn func(char rune) bool) {
+	for index, word := range s.words {
+		for word != 0 {
+			offset := bits.TrailingZeros64(word)
+			if !fn(rune(index*64 + offset)) {
+				return
+			}
+
+			word &= word - 1
+		}
+	}
+}
+
+// Intersect returns a new set of the characters in both s and the other.
+func (s Set) Intersect(other Set) Set {
+	return s.combine(other, func(a, b uint64) uint64 { return a & b })
+}
+
+// Len returns the number of characters in the set.
+func (s Set) Len() int {
+	return s.count
+}
+
+// RangeTable returns the set as a *unicode.RangeTable. Which can be used with
+// unicode.Is and unicode.In. E.g. unicode.Is(JoyoSet.RangeTable(), r).
+func (s Set) RangeTable() *unicode.RangeTable {
+	table := &unicode.RangeTable{}
+
+	s.eachRange(func(lo, hi rune) {
+		if hi <= 0xFFFF {
+			table.R16 = append(table.R16, unicode.Range16{Lo: uint16(lo), Hi: uint16(hi), Stride: 1})
+
+			if hi <= unicode.MaxLatin1 {
+				table.LatinOffset++
+			}
+
+			return
+		}
+
+		if lo <= 0xFFFF {
+			table.R16 = append(table.R16, unicode.Range16{Lo: uint16(lo), Hi: 0xFFFF, Stride: 1})
+			lo = 0x10000
+		}
+
+		table.R32 = append(table.R32, unicode.Range32{Lo: uint32(lo), Hi: uint32(hi), Stride: 1})
+	})
+
+	return table
+}
+
+// RegexpClass returns the set as a character class of the regexp package. Such
+// as "[一丁七万-丈]". The empty set returns a class that matches nothing.
+//
+// E.g. regexp.MustCompile("^" + JoyoSet.RegexpClass() + "+$").
+func (s Set) RegexpClass() string {
+	if s.count == 0 {
+		return `[^\x00-\x{10FFFF}]`
+	}
+
+	var class strings.Builder
+
+	class.WriteRune('[')
+
+	s.eachRange(func(lo, hi rune) {
+		writeClassRune(&class, lo)
+
+		switch {
+		case hi == lo+1:
+			writeClassRune(&class, hi)
+		case hi > lo+1:
+			class.WriteRune('-')
+			writeClassRune(&class, hi)
+		}
+	})
+
+	class.WriteRune(']')
+
+	return class.String()
+}
+
+// Runes returns the characters in the set in ascending order of the code point.
+func (s Set) Runes() []rune {
+	chars := make([]rune, 0, s.count)
+
+	s.Each(func(char rune) bool {
+		chars = append(chars, char)
+
+		return true
+	})
+
+	return chars
+}
+
+// String is a Stringer interface implementation. It returns the characters in
+// the set in ascending order of the code point.
+func (s Set) String() string {
+	return string(s.Runes())
+}
+
+// Union returns a new set of the characters in s or the other.
+func (s Set) Union(other Set) Set {
+	return s.combine(other, func(a, b uint64) uint64 { return a | b })
+}
+
+// combine returns a new set by applying op to each word of the bitsets.
+func (s Set) combine(other Set, operation func(a, b uint64) uint64) Set {
+	size := len(s.words)
+	if len(other.words) > size {
+		size = len(other.words)
+	}
+
+	result := Set{words: make([]uint64, size)}
+
+	for index := range result.words {
+		var a, b uint64
+
+		if index < len(s.words) {
+			a = s.words[index]
+		}
+
+		if index < len(other.words) {
+			b = other.words[index]
+		}
+
+		result.words[index] = operation(a, b)
+		result.count += bits.OnesCount64(result.words[index])
+	}
+
+	// Trim the trailing empty words.
+	for len(result.words) > 0 && result.words[len(result.words)-1] == 0 {
+		result.words = result.words[:len(result.words)-1]
+	}
+
+	return result
+}
+
+// eachRange calls fn for each range of the consecutive characters in the set
+// in ascending order.
+func (s Set) eachRange(fn func(lo, hi rune)) {
+	var lo, hi rune = -1, -1
+
+	s.Each(func(char rune) bool {
+		if char != hi+1 || lo < 0 {
+			if lo >= 0 {
+				fn(lo, hi)
+			}
+
+			lo = char
+		}
+
+		hi = char
+
+		return true
+	})
+
+	if lo >= 0 {
+		fn(lo, hi)
+	}
+}
+
+// ----------------------------------------------------------------------------
+//  Private functions
+// ----------------------------------------------------------------------------
+
+// buildPredefinedSets builds the predefined sets from the embedded dictionaries.
+func buildPredefinedSets() {
+	var joyo, kyuJitai, jinmeiyo []rune
+
+	for char := range kanjiDict {
+		switch {
+		case kanjiDict.IsJoyoKanji(char):
+			joyo = append(joyo, char)
+		case kanjiDict.IsKyuJitai(char):
+			kyuJitai = append(kyuJitai, char)
+		}
+	}
+
+	for char := range jinmeiyoDict {
+		jinmeiyo = append(jinmeiyo, char)
+	}
+
+	JoyoSet = NewSet(joyo...)
+	KyuJitaiSet = NewSet(kyuJitai...)
+	JinmeiyoSet = NewSet(jinmeiyo...)
+	JoyoTable = JoyoSet.RangeTable()
+}
+
+// writeClassRune writes the character to the regexp character class. The
+// characters other than the graphic ones and the special characters of the
+// class are escaped.
+func writeClassRune(class *strings.Builder, char rune) {
+	if char < utf8.RuneSelf && !unicode.IsLetter(char) && !unicode.IsDigit(char) || !unicode.IsGraphic(char) {
+		fmt.Fprintf(class, `\x{%X}`, char)
+
+		return
+	}
+
+	class.WriteRune(char)
+}
@@ -0,0 +1,167 @@
+package kanjis
+
+import (
+	"regexp"
+	"testing"
+	"unicode"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ----------------------------------------------------------------------------
+//  Predefined sets
+// ----------------------------------------------------------------------------
+
+func TestPredefinedSets(t *testing.T) {
+	assert.Equal(t, 2136, JoyoSet.Len())
+	assert.Equal(t, LenJinmeiyo(), JinmeiyoSet.Len())
+	assert.True(t, KyuJitaiSet.Contains('學'))
+	assert.False(t, KyuJitaiSet.Contains('学'))
+
+	assert.Zero(t, JoyoSet.Intersect(KyuJitaiSet).Len(), "Joyo Kanji and old forms should be disjoint")
+	assert.Zero(t, JoyoSet.Intersect(JinmeiyoSet).Len(), "Joyo Kanji and Jinmeiyo Kanji should be disjoint")
+
+	JoyoSet.Each(func(char rune) bool {
+		require.True(t, IsJoyoKanji(char), "char: %s", string(char))
+		require.True(t, unicode.Is(JoyoTable, char), "char: %s", string(char))
+
+		return true
+	})
+
+	for _, char := range "學亞あa𠀋" {
+		assert.False(t, unicode.Is(JoyoTable, char), "char: %s", string(char))
+	}
+}
+
+func TestGradeSet(t *testing.T) {
+	assert.Equal(t, 80, GradeSet(1).Len())
+	assert.Equal(t, 440, GradeSet(3).Len())
+	assert.Equal(t, 1026, GradeSet(6).Len())
+	assert.Equal(t, GradeSet(1).String(), GradeSet(0).String(), "grade should be clamped")
+
+	grade3Only := GradeSet(3).Difference(GradeSet(2))
+
+	assert.Equal(t, 200, grade3Only.Len())
+	assert.True(t, grade3Only.Contains('漢'))
+	assert.False(t, grade3Only.Contains('学'))
+}
+
+// ----------------------------------------------------------------------------
+//  NewSet()
+// ----------------------------------------------------------------------------
+
+func TestNewSet(t *testing.T) {
+	set := NewSet('字', '漢', '漢', -1, unicode.MaxRune+1, '𠮟')
+
+	assert.Equal(t, 3, set.Len(), "duplicate and invalid runes should be ignored")
+	assert.Equal(t, "字漢𠮟", set.String())
+	assert.Equal(t, []rune{'字', '漢', '𠮟'}, set.Runes())
+	assert.True(t, set.Contains('漢'))
+	assert.False(t, set.Contains('学'))
+	assert.False(t, set.Contains(-1))
+	assert.False(t, set.Contains(unicode.MaxRune))
+}
+
+func TestNewSet_empty(t *testing.T) {
+	for _, set := range []Set{NewSet(), NewSet(-1), {}} {
+		assert.Zero(t, set.Len())
+		assert.Empty(t, set.String())
+		assert.False(t, set.Contains(0))
+		assert.Empty(t, set.RangeTable().R16)
+		assert.False(t, regexp.MustCompile(set.RegexpClass()).MatchString("a\x00"),
+			"empty class should match nothing")
+	}
+}
+
+// ----------------------------------------------------------------------------
+//  Set.Union(), Set.Intersect() and Set.Difference()
+// ----------------------------------------------------------------------------
+
+func TestSet_operations(t *testing.T) {
+	setA := NewSet('一', '二', '三')
+	setB := NewSet('二', '三', '𠮟')
+
+	// In ascending order of the code point. '三' (U+4E09) < '二' (U+4E8C)
+	assert.Equal(t, "一三二𠮟", setA.Union(setB).String())
+	assert.Equal(t, "三二", setA.Intersect(setB).String())
+	assert.Equal(t, "一", setA.Difference(setB).String())
+	assert.Equal(t, "𠮟", setB.Difference(setA).String())
+	assert.Equal(t, 4, setA.Union(setB).Len())
+
+	// Receivers should not be modified
+	assert.Equal(t, "一三二", setA.String())
+	assert.Equal(t, "三二𠮟", setB.String())
+
+	// Trailing empty words should be trimmed
+	assert.Equal(t, setA.Difference(setB).words, NewSet('一').words)
+}
+
+// ----------------------------------------------------------------------------
+//  Set.Each()
+// ----------------------------------------------------------------------------
+
+func TestSet_Each_stop(t *testing.T) {
+	var visited []rune
+
+	NewSet('a', 'b', 'c').Each(func(char rune) bool {
+		visited = append(visited, char)
+
+		return char != 'b'
+	})
+
+	assert.Equal(t, []rune{'a', 'b'}, visited)
+}
+
+// ----------------------------------------------------------------------------
+//  Set.RangeTable()
+// ----------------------------------------------------------------------------
+
+func TestSet_RangeTable(t *testing.T) {
+	set := NewSet('a', 'b', 'c', 'z', 'é', '漢', '￿', '\U00010000', '𠮟')
+	table := set.RangeTable()
+
+	assert.Equal(t, []unicode.Range16{
+		{Lo: 'a', Hi: 'c', Stride: 1},
+		{Lo: 'z', Hi: 'z', Stride: 1},
+		{Lo: 'é', Hi: 'é', Stride: 1},
+		{Lo: '漢', Hi: '漢', Stride: 1},
+		{Lo: 0xFFFF, Hi: 0xFFFF, Stride: 1},
+	}, table.R16)
+	assert.Equal(t, []unicode.Range32{
+		{Lo: 0x10000, Hi: 0x10000, Stride: 1},
+		{Lo: '𠮟', Hi: '𠮟', Stride: 1},
+	}, table.R32)
+	assert.Equal(t, 3, table.LatinOffset)
+
+	for char := rune(0); char <= 0x20000+0xFFFF; char++ {
+		require.Equal(t, set.Contains(char), unicode.Is(table, char), "char: %U", char)
+	}
+}
+
+func TestSet_RangeTable_across_planes(t *testing.T) {
+	table := NewSet('￾', '￿', '\U00010000', '\U00010001').RangeTable()
+
+	assert.Equal(t, []unicode.Range16{{Lo: 0xFFFE, Hi: 0xFFFF, Stride: 1}}, table.R16)
+	assert.Equal(t, []unicode.Range32{{Lo: 0x10000, Hi: 0x10001, Stride: 1}}, table.R32)
+}
+
+// ----------------------------------------------------------------------------
+//  Set.RegexpClass()
+// ----------------------------------------------------------------------------
+
+func TestSet_RegexpClass(t *testing.T) {
+	set := NewSet('一', '丁', '七', '万', '丈', '三', '-', ']', '\n')
+
+	assert.Equal(t, `[\x{A}\x{2D}\x{5D}一丁七万-三]`, set.RegexpClass())
+
+	pattern := regexp.MustCompile("^" + set.RegexpClass() + "+$")
+
+	assert.True(t, pattern.MatchString("一丁-]\n"))
+	assert.False(t, pattern.MatchString("一二"))
+
+	joyoOnly := regexp.MustCompile("^" + JoyoSet.RegexpClass() + "+$")
+
+	assert.True(t, joyoOnly.MatchString("常用漢字"))
+	assert.False(t, joyoOnly.MatchString("常用漢字表の學"))
+}
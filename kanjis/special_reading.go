@@ -0,0 +1,164 @@
+package kanjis
+
+import (
+	"io"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/KEINOS/go-joyokanjis/kanjis/converter"
+	"github.com/KEINOS/go-joyokanjis/kanjis/kanji"
+	"github.com/pkg/errors"
+)
+
+// kanjiNumerals is the set of the kanji used as numerals. Used to skip the
+// words that are part of a number. Such as "一日" in "十一日".
+var kanjiNumerals = map[rune]struct{}{
+	'〇': {}, '一': {}, '二': {}, '三': {}, '四': {}, '五': {}, '六': {}, '七': {},
+	'八': {}, '九': {}, '十': {}, '百': {}, '千': {}, '万': {},
+}
+
+// specialReadings is the map of the words in kanji.SpecialReadings to their
+// readings, and maxLenSpecialWord is the number of characters of the longest
+// word in it.
+var specialReadings, maxLenSpecialWord = func() (map[string]string, int) {
+	readings := make(map[string]string)
+	maxLen := 0
+
+	for _, entry := range kanji.SpecialReadings {
+		for _, word := range entry.Words {
+			readings[word] = entry.Reading
+
+			if lenWord := utf8.RuneCountInString(word); lenWord > maxLen {
+				maxLen = lenWord
+			}
+		}
+	}
+
+	return readings, maxLen
+}()
+
+// ----------------------------------------------------------------------------
+//  Type: SpecialWord
+// ----------------------------------------------------------------------------
+
+// SpecialWord is a record of a word with special reading (付表) found by
+// FindSpecialWords.
+type SpecialWord struct {
+	// Pos is the position of the first character of the word in the input.
+	Pos converter.Position `json:"pos"`
+	// Word is the word found. E.g. "明日".
+	Word string `json:"word"`
+	// Reading is the special reading of the word. E.g. "あす".
+	Reading string `json:"reading"`
+}
+
+// ----------------------------------------------------------------------------
+//  Public functions
+// ----------------------------------------------------------------------------
+
+// FindSpecialWords reads the input and returns the words with special readings
+// (付表) of the Joyo Kanji table in the order of appearance. Such as "明日"
+// (あす) and "大人" (おとな). The longest word is preferred and the words do not
+// overlap.
+//
+// Note that the words are matched as written and the context is not considered.
+// Thus, "今日" in "今日的" is reported as well, and the conjugated forms of the
+// verbs (such as "手伝って" for "手伝う") are not matched. The words that are
+// part of a number are skipped. E.g. "一日" in "十一日".
+func FindSpecialWords(input io.Reader) ([]SpecialWord, error) {
+	var (
+		words     []SpecialWord
+		line      []rune
+		positions []converter.Position
+	)
+
+	flush := func() {
+		words = append(words, findSpecialWordsInLine(line, positions)...)
+		line = line[:0]
+		positions = positions[:0]
+	}
+
+	err := converter.Scan(input, func(in rune, pos converter.Position) {
+		line = append(line, in)
+		positions = append(positions, pos)
+
+		if in == '\n' {
+			flush()
+		}
+	})
+	if err != nil {
+		return words, errors.Wrap(err, "failed to read the input")
+	}
+
+	flush()
+
+	return words, nil
+}
+
+// FindSpecialWordsString is similar to FindSpecialWords but for string.
+func FindSpecialWordsString(input string) []SpecialWord {
+	// Reading from strings.Reader never fails.
+	words, _ := FindSpecialWords(strings.NewReader(input))
+
+	return words
+}
+
+// SpecialReading returns the special reading of the given word if it is one of
+// the words in the table of the special readings (付表) of the Joyo Kanji table.
+// E.g. "あす" for "明日".
+//
+// Such words are Joyo-compliant even though the readings are not of each kanji.
+func SpecialReading(word string) (string, bool) {
+	reading, ok := specialReadings[word]
+
+	return reading, ok
+}
+
+// ----------------------------------------------------------------------------
+//  Private functions
+// ----------------------------------------------------------------------------
+
+// findSpecialWordsInLine returns the words with special readings in the line.
+// The positions are the ones of each character in the line.
+func findSpecialWordsInLine(line []rune, positions []converter.Position) []SpecialWord {
+	var words []SpecialWord
+
+	for index := 0; index < len(line); index++ {
+		for lenWord := maxLenSpecialWord; lenWord > 1; lenWord-- {
+			if index+lenWord > len(line) {
+				continue
+			}
+
+			word := string(line[index : index+lenWord])
+
+			reading, ok := specialReadings[word]
+			if !ok || isPartOfNumber(line, index, index+lenWord) {
+				continue
+			}
+
+			words = append(words, SpecialWord{Pos: positions[index], Word: word, Reading: reading})
+			index += lenWord - 1
+
+			break
+		}
+	}
+
+	return words
+}
+
+// isPartOfNumber returns true if the word of line[start:end] starts with a kanji
+// numeral preceded by another one, or ends with a kanji numeral followed by
+// another one. E.g. "一日" in "十一日" and "二十" in "二十二日".
+func isPartOfNumber(line []rune, start, end int) bool {
+	isNumeral := func(index int) bool {
+		if index < 0 || index >= len(line) {
+			return false
+		}
+
+		_, ok := kanjiNumerals[line[index]]
+
+		return ok
+	}
+
+	return (isNumeral(start) && isNumeral(start-1)) || (isNumeral(end-1) && isNumeral(end))
+}
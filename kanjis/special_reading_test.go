@@ -0,0 +1,90 @@
+package kanjis
+
+import (
+	"testing"
+
+	"github.com/KEINOS/go-joyokanjis/kanjis/converter"
+	"github.com/KEINOS/go-joyokanjis/kanjis/kana"
+	"github.com/KEINOS/go-joyokanjis/kanjis/kanji"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ----------------------------------------------------------------------------
+//  SpecialReading()
+// ----------------------------------------------------------------------------
+
+// This test ensures that the words consist of Joyo Kanji and kana only.
+func TestSpecialReading_all_joyo(t *testing.T) {
+	for _, entry := range kanji.SpecialReadings {
+		for _, word := range entry.Words {
+			for _, char := range word {
+				require.True(t, IsJoyoKanji(char) || kana.IsHiragana(char),
+					"word: %s, char: %s", word, string(char))
+			}
+
+			reading, ok := SpecialReading(word)
+
+			require.True(t, ok)
+			require.Equal(t, entry.Reading, reading)
+		}
+	}
+}
+
+func TestSpecialReading(t *testing.T) {
+	for _, test := range []struct {
+		word   string
+		expect string
+	}{
+		{word: "明日", expect: "あす"},
+		{word: "大人", expect: "おとな"},
+		{word: "今日", expect: "きょう"},
+		{word: "伯父", expect: "おじ"},
+		{word: "叔父", expect: "おじ"},
+		{word: "十重二十重", expect: "とえはたえ"},
+	} {
+		reading, ok := SpecialReading(test.word)
+
+		require.True(t, ok, "word: %s", test.word)
+		assert.Equal(t, test.expect, reading, "word: %s", test.word)
+	}
+
+	_, ok := SpecialReading("明後日")
+	assert.False(t, ok, "words not in the table should return false")
+}
+
+// ----------------------------------------------------------------------------
+//  FindSpecialWords()
+// ----------------------------------------------------------------------------
+
+func TestFindSpecialWords_nil_input(t *testing.T) {
+	words, err := FindSpecialWords(nil)
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to read the input")
+	assert.Nil(t, words)
+}
+
+func TestFindSpecialWordsString(t *testing.T) {
+	input := "今日は大人と\n二十日に果物を買う。"
+
+	assert.Equal(t, []SpecialWord{
+		{Pos: converter.Position{Offset: 0, Line: 1, Column: 1}, Word: "今日", Reading: "きょう"},
+		{Pos: converter.Position{Offset: 9, Line: 1, Column: 4}, Word: "大人", Reading: "おとな"},
+		{Pos: converter.Position{Offset: 19, Line: 2, Column: 1}, Word: "二十日", Reading: "はつか"},
+		{Pos: converter.Position{Offset: 31, Line: 2, Column: 5}, Word: "果物", Reading: "くだもの"},
+	}, FindSpecialWordsString(input))
+}
+
+func TestFindSpecialWordsString_numerals(t *testing.T) {
+	words := FindSpecialWordsString("一日と十一日と二十二日と一人")
+
+	require.Len(t, words, 2, "words continued from numerals should be skipped")
+	assert.Equal(t, "一日", words[0].Word)
+	assert.Equal(t, "一人", words[1].Word)
+}
+
+func TestFindSpecialWordsString_no_words(t *testing.T) {
+	assert.Empty(t, FindSpecialWordsString(""))
+	assert.Empty(t, FindSpecialWordsString("明後日は晴れ"))
+}
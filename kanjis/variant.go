@@ -0,0 +1,267 @@
+package kanjis
+
+import (
+	"sort"
+	"sync"
+	"unicode/utf8"
+
+	"github.com/KEINOS/go-joyokanjis/kanjis/kana"
+	"github.com/KEINOS/go-joyokanjis/kanjis/kanji"
+	"golang.org/x/text/unicode/norm"
+	"golang.org/x/text/width"
+)
+
+// Private global variables for the variant equivalence classes.
+var (
+	// variantCanonical is the map of a character to the representative of its
+	// equivalence class. Characters without variants are not in the map.
+	variantCanonical map[rune]rune
+	// variantMembers is the map of the representative to the members of its
+	// equivalence class in ascending order.
+	variantMembers map[rune][]rune
+	// voicedKatakana is the map of the katakana and the combining voiced sound
+	// mark to the composed katakana. E.g. {'カ', U+3099} to 'ガ'.
+	voicedKatakana map[[2]rune]rune
+	// onceVariant builds the equivalence classes only once on the first use.
+	onceVariant sync.Once
+)
+
+// Ranges of the CJK radicals and compatibility ideographs that are normalized
+// to the ordinary kanji.
+var compatibilityRanges = [][2]rune{
+	{0x2E80, 0x2FDF},   // CJK Radicals Supplement and Kangxi Radicals
+	{0xF900, 0xFAFF},   // CJK Compatibility Ideographs
+	{0x2F800, 0x2FA1F}, // CJK Compatibility Ideographs Supplement
+}
+
+// ----------------------------------------------------------------------------
+//  Type: FoldOption
+// ----------------------------------------------------------------------------
+
+// FoldOption is the bit flags of the additional equivalences for EqualFold.
+type FoldOption uint
+
+// FoldVariant compares the kanji modulo old kanji (kyujitai), variant kanji
+// (itaiji) and compatibility ideographs only. Which is always applied.
+const FoldVariant FoldOption = 0
+
+const (
+	// FoldKana considers hiragana and katakana as equal. E.g. "あ" and "ア".
+	FoldKana FoldOption = 1 << iota
+	// FoldWidth considers halfwidth and fullwidth forms as equal. E.g. "Ａ" and
+	// "A", or "ｶﾞ" and "ガ".
+	FoldWidth
+)
+
+// ----------------------------------------------------------------------------
+//  Public functions
+// ----------------------------------------------------------------------------
+
+// EqualFold returns true if the given strings are equal modulo old kanji
+// (kyujitai), variant kanji (itaiji) and compatibility ideographs. E.g. "渡辺",
+// "渡邊" and "渡邉" are equal.
+//
+// Use the options to also ignore the difference between hiragana and katakana
+// (FoldKana) and the character width (FoldWidth). The options can be combined.
+// E.g. FoldKana|FoldWidth.
+//
+// It does not allocate memory, so it is suitable as a comparator for a large
+// number of records.
+func EqualFold(a, b string, opts FoldOption) bool {
+	onceVariant.Do(buildVariantClasses)
+
+	for len(a) > 0 && len(b) > 0 {
+		charA, sizeA := nextFolded(a, opts)
+		charB, sizeB := nextFolded(b, opts)
+
+		if charA != charB {
+			return false
+		}
+
+		a, b = a[sizeA:], b[sizeB:]
+	}
+
+	return len(a) == len(b)
+}
+
+// VariantClass returns the equivalence class of the given character in
+// ascending order. Which is the list of old kanji (kyujitai), variant kanji
+// (itaiji) and compatibility ideographs that are considered the same as the
+// character. E.g. '辺' to ['辺', '邉', '邊'].
+//
+// The class always contains the given character itself.
+func VariantClass(char rune) []rune {
+	onceVariant.Do(buildVariantClasses)
+
+	representative, ok := variantCanonical[char]
+	if !ok {
+		return []rune{char}
+	}
+
+	return append([]rune(nil), variantMembers[representative]...)
+}
+
+// ----------------------------------------------------------------------------
+//  Private functions
+// ----------------------------------------------------------------------------
+
+// buildVariantClasses builds the equivalence classes from the embedded
+// dictionaries, kanji.NonJoyoOld2NewMap, kanji.ItaijiMap and the compatibility
+// ideographs.
+func buildVariantClasses() {
+	parents := make(map[rune]rune)
+
+	find := func(char rune) rune {
+		for {
+			parent, ok := parents[char]
+			if !ok || parent == char {
+				return char
+			}
+
+			char = parent
+		}
+	}
+
+	union := func(a, b rune) {
+		rootA, rootB := find(a), find(b)
+		if rootA == rootB {
+			parents[rootA] = rootA
+
+			return
+		}
+
+		// Keep the smaller code point as the root for stable results.
+		if rootB < rootA {
+			rootA, rootB = rootB, rootA
+		}
+
+		parents[rootA] = rootA
+		parents[rootB] = rootA
+	}
+
+	for char, kanjiData := range kanjiDict {
+		if kanjiData.IsKyuJitai {
+			union(rune(kanjiData.ShinJitai), char)
+		}
+	}
+
+	for oldKanji, newKanji := range kanji.NonJoyoOld2NewMap {
+		union(newKanji, oldKanji)
+	}
+
+	for variant, standard := range kanji.ItaijiMap {
+		union(standard, variant)
+	}
+
+	for char, jinmeiyo := range jinmeiyoDict {
+		if jinmeiyo.ShinJitai != 0 {
+			union(rune(jinmeiyo.ShinJitai), char)
+		}
+	}
+
+	for _, compatRange := range compatibilityRanges {
+		for char := compatRange[0]; char <= compatRange[1]; char++ {
+			normalized := []rune(norm.NFKC.String(string(char)))
+			if len(normalized) == 1 && normalized[0] != char {
+				union(normalized[0], char)
+			}
+		}
+	}
+
+	variantCanonical = make(map[rune]rune, len(parents))
+	variantMembers = make(map[rune][]rune)
+
+	for char := range parents {
+		representative := find(char)
+
+		variantCanonical[char] = representative
+		variantMembers[representative] = append(variantMembers[representative], char)
+	}
+
+	for _, members := range variantMembers {
+		sort.Slice(members, func(i, j int) bool { return members[i] < members[j] })
+	}
+
+	voicedKatakana = make(map[[2]rune]rune)
+
+	for char := 'ァ'; char <= 'ヺ'; char++ {
+		if decomposed := []rune(norm.NFD.String(string(char))); len(decomposed) == 2 {
+			voicedKatakana[[2]rune{decomposed[0], decomposed[1]}] = char
+		}
+	}
+}
+
+// foldRune returns the character folded by the options. The halfwidth voiced
+// sound marks are not composed. See nextFolded.
+func foldRune(char rune, opts FoldOption) rune {
+	if opts&FoldWidth != 0 {
+		char = foldWidth(char)
+	}
+
+	if representative, ok := variantCanonical[char]; ok {
+		char = representative
+	}
+
+	if opts&FoldKana != 0 {
+		char = kana.ToHiragana(char)
+	}
+
+	return char
+}
+
+// nextFolded returns the first character of the input folded by the options
+// and the number of bytes consumed.
+func nextFolded(input string, opts FoldOption) (rune, int) {
+	char, size := utf8.DecodeRuneInString(input)
+
+	// Compose the halfwidth voiced sound marks. E.g. "ｶﾞ" to "ガ".
+	if opts&FoldWidth != 0 {
+		if mark, sizeMark := utf8.DecodeRuneInString(input[size:]); isHalfwidthMark(mark) {
+			if composed, ok := composeVoiced(foldWidth(char), mark); ok {
+				char = composed
+				size += sizeMark
+			}
+		}
+	}
+
+	return foldRune(char, opts), size
+}
+
+// composeVoiced returns the katakana composed with the given halfwidth voiced
+// sound mark ('ﾞ' or 'ﾟ'). E.g. 'カ' and 'ﾞ' to 'ガ'.
+func composeVoiced(char, mark rune) (rune, bool) {
+	combining := '\u3099' // COMBINING KATAKANA-HIRAGANA VOICED SOUND MARK
+	if mark == 'ﾟ' {
+		combining = '\u309A' // COMBINING KATAKANA-HIRAGANA SEMI-VOICED SOUND MARK
+	}
+
+	composed, ok := voicedKatakana[[2]rune{char, combining}]
+
+	return composed, ok
+}
+
+// isHalfwidthMark returns true if the given character is a halfwidth voiced or
+// semi-voiced sound mark ('ﾞ' or 'ﾟ').
+func isHalfwidthMark(char rune) bool {
+	return char == 'ﾞ' || char == 'ﾟ'
+}
+
+// foldWidth returns the fullwidth form of the halfwidth katakana and the
+// narrow form of the other fullwidth characters. E.g. 'ｱ' to 'ア' and 'Ａ' to
+// 'A'.
+func foldWidth(char rune) rune {
+	props := width.LookupRune(char)
+
+	switch props.Kind() {
+	case width.EastAsianHalfwidth:
+		if wide := props.Wide(); wide != 0 {
+			return wide
+		}
+	case width.EastAsianFullwidth:
+		if narrow := props.Narrow(); narrow != 0 {
+			return narrow
+		}
+	}
+
+	return char
+}
@@ -0,0 +1,110 @@
+package kanjis
+
+import (
+	"testing"
+
+	"github.com/KEINOS/go-joyokanjis/kanjis/kanji"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ----------------------------------------------------------------------------
+//  kanji.ItaijiMap
+// ----------------------------------------------------------------------------
+
+// This test ensures that the variants in kanji.ItaijiMap are not registered as
+// old kanji elsewhere. Otherwise, they should be fixed by FixAsJoyo.
+func TestItaijiMap_not_kyujitai(t *testing.T) {
+	for variant, standard := range kanji.ItaijiMap {
+		assert.NotEqual(t, variant, standard)
+		assert.False(t, IsKyuJitai(variant),
+			"%s (%U) is registered as kyujitai", string(variant), variant)
+		assert.False(t, IsJoyoKanji(variant),
+			"%s (%U) is a Joyo Kanji", string(variant), variant)
+	}
+}
+
+// ----------------------------------------------------------------------------
+//  EqualFold()
+// ----------------------------------------------------------------------------
+
+func TestEqualFold(t *testing.T) {
+	for _, test := range []struct {
+		a      string
+		b      string
+		opts   FoldOption
+		expect bool
+	}{
+		// Old kanji (kyujitai)
+		{a: "渡辺", b: "渡邊", opts: FoldVariant, expect: true},
+		{a: "渡邊", b: "渡邉", opts: FoldVariant, expect: true},
+		{a: "齋藤", b: "斎藤", opts: FoldVariant, expect: true},
+		// Different kanji
+		{a: "斉藤", b: "斎藤", opts: FoldVariant, expect: false},
+		// Variant kanji (itaiji)
+		{a: "髙橋", b: "高橋", opts: FoldVariant, expect: true},
+		{a: "\uFA11", b: "崎", opts: FoldVariant, expect: true},
+		// Compatibility ideograph and Kangxi radical
+		{a: "\uFA47", b: "漢", opts: FoldVariant, expect: true},
+		{a: "\u2F08", b: "人", opts: FoldVariant, expect: true},
+		// Kana
+		{a: "わたなべ", b: "ワタナベ", opts: FoldVariant, expect: false},
+		{a: "わたなべ", b: "ワタナベ", opts: FoldKana, expect: true},
+		// Width
+		{a: "ﾜﾀﾅﾍﾞ", b: "ワタナベ", opts: FoldKana, expect: false},
+		{a: "ﾜﾀﾅﾍﾞ", b: "ワタナベ", opts: FoldWidth, expect: true},
+		{a: "ﾊﾟﾝ", b: "パン", opts: FoldWidth, expect: true},
+		{a: "ﾜﾀﾅﾍﾞ", b: "わたなべ", opts: FoldKana | FoldWidth, expect: true},
+		{a: "ＡＢＣ１", b: "ABC1", opts: FoldWidth, expect: true},
+		// Halfwidth voiced sound mark that can not be composed
+		{a: "ｱﾞ", b: "ア\u3099", opts: FoldWidth, expect: true},
+		// Length
+		{a: "渡辺", b: "渡辺様", opts: FoldVariant, expect: false},
+		{a: "", b: "", opts: FoldVariant, expect: true},
+	} {
+		assert.Equal(t, test.expect, EqualFold(test.a, test.b, test.opts),
+			"a: %q, b: %q, opts: %d", test.a, test.b, test.opts)
+		assert.Equal(t, test.expect, EqualFold(test.b, test.a, test.opts),
+			"a: %q, b: %q, opts: %d (swapped)", test.b, test.a, test.opts)
+	}
+}
+
+func TestEqualFold_no_allocation(t *testing.T) {
+	allocs := testing.AllocsPerRun(100, func() {
+		_ = EqualFold("渡邊ﾜﾀﾅﾍﾞ", "渡辺わたなべ", FoldKana|FoldWidth)
+	})
+
+	require.Zero(t, allocs)
+}
+
+// ----------------------------------------------------------------------------
+//  VariantClass()
+// ----------------------------------------------------------------------------
+
+func TestVariantClass(t *testing.T) {
+	assert.Equal(t, []rune{'辺', '邉', '邊'}, VariantClass('辺'))
+	assert.Equal(t, []rune{'辺', '邉', '邊'}, VariantClass('邊'))
+	assert.Equal(t, []rune{'崎', '嵜', '\uFA11'}, VariantClass('崎'))
+	assert.Equal(t, []rune{'a'}, VariantClass('a'))
+}
+
+// Every member of the class must have the same class.
+func TestVariantClass_symmetric(t *testing.T) {
+	for _, char := range []rune{'辺', '弁', '台', '高', '漢', '人'} {
+		class := VariantClass(char)
+
+		require.Contains(t, class, char)
+
+		for _, member := range class {
+			require.Equal(t, class, VariantClass(member))
+		}
+	}
+}
+
+// The returned class must be a copy.
+func TestVariantClass_copy(t *testing.T) {
+	class := VariantClass('辺')
+	class[0] = 'x'
+
+	assert.Equal(t, '辺', VariantClass('辺')[0])
+}